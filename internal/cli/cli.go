@@ -0,0 +1,159 @@
+package cli
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// Exit codes returned by Run
+const (
+	ExitOK    = 0
+	ExitError = 1
+	ExitUsage = 2
+)
+
+// command is a single subcommand of the CLI
+type command struct {
+	name    string
+	args    string
+	summary string
+
+	// run parses the subcommand's flags and arguments and writes its output
+	run func(env *env, args []string) error
+}
+
+// env is what every command runs against
+type env struct {
+	cmd    command
+	stdout io.Writer
+	stderr io.Writer
+}
+
+var commands = []command{
+	{"standings", "[--conference AFC]", "print the standings, grouped by division", runStandings},
+	{"seed", "[--conference AFC] [--week N]", "print the playoff seeds of each conference", runSeed},
+	{"history", "[--conference AFC]", "print each team's seed and clinch status after every week", runHistory},
+	{"tiebreak", "TEAM TEAM...", "break a tie between teams and print every tiebreaker applied", runTiebreak},
+	{"draft-order", "", "print the draft order", runDraftOrder},
+	{"opponents", "[--conference AFC]", "print each team's home and away opponents for next season", runOpponents},
+	{"power", "[--week N] [--prior FILE]", "print power rankings from Elo ratings", runPower},
+	{"simulate", "[--iterations N] [--seed N] [--model elo|spread] [--prior FILE] [--spreads FILE]", "simulate the rest of the season and print playoff and draft odds", runSimulate},
+	{"whatif", "PICK...", "pick results for unplayed games and print the resulting seeds", runWhatIf},
+	{"report", "[--output FILE]", "write an HTML report of the standings and playoff picture, week by week", runReport},
+	{"tui", "--input FILE | --cache FILE", "pick remaining games interactively and watch the seeds change, offline", runTUI},
+	{"serve", "[--addr :8080]", "serve standings, seeds, tiebreakers, the draft order and what-ifs as a JSON API", runServe},
+}
+
+// usageError is returned for bad flags or arguments, and makes Run exit with ExitUsage
+type usageError struct {
+	err error
+
+	// shown is true when the flag package has already printed the error along with the usage
+	shown bool
+}
+
+func (e usageError) Error() string {
+	return e.err.Error()
+}
+
+func usagef(format string, args ...any) error {
+	return usageError{err: fmt.Errorf(format, args...)}
+}
+
+// Run runs the CLI with the given arguments (without the program name) and returns the exit code
+func Run(args []string, stdout, stderr io.Writer) int {
+	if len(args) == 0 || args[0] == "-h" || args[0] == "--help" || args[0] == "help" {
+		usage(stderr)
+		if len(args) == 0 {
+			return ExitUsage
+		}
+		return ExitOK
+	}
+
+	for _, cmd := range commands {
+		if cmd.name != args[0] {
+			continue
+		}
+
+		err := cmd.run(&env{cmd: cmd, stdout: stdout, stderr: stderr}, args[1:])
+		var uerr usageError
+		switch {
+		case err == nil:
+			return ExitOK
+		case errors.Is(err, flag.ErrHelp):
+			return ExitOK
+		case errors.As(err, &uerr) && uerr.shown:
+			return ExitUsage
+		case errors.As(err, &uerr):
+			fmt.Fprintf(stderr, "%s: %v\n", cmd.name, err)
+			fmt.Fprintf(stderr, "run 'nfl-app %s -h' for usage\n", cmd.name)
+			return ExitUsage
+		default:
+			fmt.Fprintf(stderr, "%s: %v\n", cmd.name, err)
+			return ExitError
+		}
+	}
+
+	fmt.Fprintf(stderr, "unknown command %q\n\n", args[0])
+	usage(stderr)
+	return ExitUsage
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "usage: nfl-app COMMAND [flags] [args]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "commands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %-12s %s\n", cmd.name, cmd.summary)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "run 'nfl-app COMMAND -h' for the flags of a command")
+}
+
+// newFlagSet returns a flag set for the command being run that reports errors instead of exiting
+func newFlagSet(env *env) *flag.FlagSet {
+	cmd := env.cmd
+	fs := flag.NewFlagSet(cmd.name, flag.ContinueOnError)
+	fs.SetOutput(env.stderr)
+	fs.Usage = func() {
+		fmt.Fprintf(env.stderr, "usage: nfl-app %s [flags] %s\n\n%s\n\nflags:\n", cmd.name, cmd.args, cmd.summary)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// parse parses flags anywhere among the arguments, so "tiebreak BUF MIA --format json" works
+// as well as "tiebreak --format json BUF MIA", and returns the remaining positional arguments
+func parse(fs *flag.FlagSet, args []string) ([]string, error) {
+	positional := make([]string, 0)
+	for {
+		if err := fs.Parse(args); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				return nil, err
+			}
+			return nil, usageError{err: err, shown: true}
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+// oneOf checks a flag value against its allowed values
+func oneOf(flagName, value string, allowed ...string) error {
+	for _, a := range allowed {
+		if value == a {
+			return nil
+		}
+	}
+	sorted := append([]string(nil), allowed...)
+	sort.Strings(sorted)
+	return usagef("--%s must be one of %s, got %q", flagName, strings.Join(sorted, ", "), value)
+}
@@ -0,0 +1,152 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"nfl-app/internal/scraper"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// season2023 writes the 2023 season from the golden snapshot with week 18 still to play, returning the file's path
+func season2023(t *testing.T) string {
+	t.Helper()
+	f, err := os.Open(filepath.Join("..", "golden", "testdata", "seasons", "2023", "games.csv"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	rows, err := scraper.ReadCSV(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	regular, _ := scraper.SplitPlayoffs(rows)
+	for i, row := range regular {
+		if row.Week == "18" {
+			regular[i].PtsWin, regular[i].PtsLose = "", ""
+		}
+	}
+
+	path := filepath.Join(t.TempDir(), "games.csv")
+	out, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer out.Close()
+	if err := scraper.WriteCSV(out, regular); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestRun(t *testing.T) {
+	games := season2023(t)
+
+	// The same games without dates, so the season cannot be told from them
+	undated := filepath.Join(t.TempDir(), "undated.json")
+	rows := []scraper.ScrapedRow{{Week: "1", Winner: "Buffalo Bills", Loser: "Miami Dolphins", PtsWin: "24", PtsLose: "17"}}
+	data, _ := json.Marshal(rows)
+	if err := os.WriteFile(undated, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	// Buffalo are sure to win in Miami, and the Steelers in Baltimore all but sure
+	spreads := filepath.Join(t.TempDir(), "spreads.csv")
+	if err := os.WriteFile(spreads, []byte("Away,Home,Spread\nBUF,MIA,-200\nsteelers,ravens,-30\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		args []string
+		code int
+
+		// stdout holds text the output must contain, stderr text the errors must
+		stdout []string
+		stderr string
+	}{
+		{"standings", []string{"standings", "--conference", "AFC"}, ExitOK,
+			[]string{"AFC East\n", "Team  ", "Div #", "Berth #", "Buffalo Bills", "x clinched playoff berth"}, ""},
+		{"standings csv", []string{"standings", "--format", "csv"}, ExitOK,
+			[]string{"Group,Team,W,L,T,Pct", "\nNFC West,"}, ""},
+		{"standings bad conference", []string{"standings", "--conference", "XFL"}, ExitUsage, nil, "unknown conference"},
+		{"standings bad format", []string{"standings", "--format", "xml"}, ExitUsage, nil, "--format must be one of"},
+		{"standings bad sort", []string{"standings", "--sort", "height"}, ExitUsage, nil, "--sort must be one of"},
+		{"standings bad flag", []string{"standings", "--colour"}, ExitUsage, nil, "flag provided but not defined"},
+		{"standings stray argument", []string{"standings", "BUF"}, ExitUsage, nil, "unexpected arguments BUF"},
+
+		{"seed", []string{"seed", "--conference", "NFC"}, ExitOK,
+			[]string{"NFC\nSeed  Team", "1     *-San Francisco 49ers", "-     e-Washington Commanders"}, ""},
+		{"seed week", []string{"seed", "--week", "1", "--format", "csv"}, ExitOK, []string{"Group,Seed,Team,Record"}, ""},
+		{"seed bad week", []string{"seed", "--week", "-1"}, ExitUsage, nil, "--week must be positive"},
+
+		{"tiebreak", []string{"tiebreak", "BUF", "MIA", "NYJ"}, ExitOK,
+			[]string{"Rank  Team", "1     Miami Dolphins", "3     New York Jets"}, ""},
+		{"tiebreak one team", []string{"tiebreak", "BUF"}, ExitUsage, nil, "give at least two teams"},
+		{"tiebreak unknown team", []string{"tiebreak", "BUF", "XYZ"}, ExitUsage, nil, `unknown team "XYZ"`},
+
+		{"draft-order", []string{"draft-order"}, ExitOK,
+			[]string{"Pick  Team", "1     Carolina Panthers", "The season is not over, so this order is provisional"}, ""},
+		{"draft-order json", []string{"draft-order", "--format", "json"}, ExitOK, []string{`"pick": 32`}, ""},
+
+		{"simulate", []string{"simulate", "--iterations", "20"}, ExitOK,
+			[]string{"AFC\nTeam  ", "Playoffs", "Bye", "20 simulated seasons, games decided by coin flips"}, ""},
+		{"simulate spreads", []string{"simulate", "--iterations", "20", "--model", "spread", "--spreads", spreads}, ExitOK,
+			[]string{"Buffalo Bills         100.0%    100.0%", "with pick'em for games without one, 14 of the remaining"}, ""},
+		{"simulate bad model", []string{"simulate", "--model", "dice"}, ExitUsage, nil, "--model must be one of coin, elo, spread"},
+		{"simulate spreads without the model", []string{"simulate", "--spreads", spreads}, ExitUsage, nil, "--spreads is needed"},
+		{"simulate spread model without spreads", []string{"simulate", "--model", "spread"}, ExitUsage, nil, "--spreads is needed"},
+		{"simulate bad spreads", []string{"simulate", "--model", "spread", "--spreads", games}, ExitError, nil, "wrong number of fields"},
+		{"simulate no iterations", []string{"simulate", "--iterations", "0"}, ExitUsage, nil, "--iterations must be positive"},
+		{"simulate prior without elo", []string{"simulate", "--prior", games}, ExitUsage, nil, "--prior only applies"},
+
+		{"whatif", []string{"whatif", "18:MIA>BUF", "PIT=BAL"}, ExitOK, []string{"AFC\nSeed  Team", "Miami Dolphins"}, ""},
+		{"whatif trace", []string{"whatif", "--trace", "MIA>BUF"}, ExitOK, []string{"AFC seeding\n"}, ""},
+		{"whatif bad pick", []string{"whatif", "BUF"}, ExitUsage, nil, "expected WINNER>LOSER"},
+		{"whatif unknown team", []string{"whatif", "BUF>XYZ"}, ExitUsage, nil, `unknown team "XYZ"`},
+		{"whatif unscheduled game", []string{"whatif", "BUF>SF"}, ExitError, nil, "whatif: "},
+
+		// The season comes from the dates of the input, which the opponents notes show
+		{"season from input", []string{"opponents", "--conference", "AFC"}, ExitOK, []string{"in the 2024 season"}, ""},
+		{"season given", []string{"opponents", "--season", "2022"}, ExitOK, []string{"in the 2023 season"}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			args := append(tt.args, "--input", games)
+			if code := Run(args, &stdout, &stderr); code != tt.code {
+				t.Fatalf("exit code %d, expected %d\nstderr: %s", code, tt.code, stderr.String())
+			}
+			for _, want := range tt.stdout {
+				if !strings.Contains(stdout.String(), want) {
+					t.Errorf("expected the output to contain %q, got:\n%s", want, stdout.String())
+				}
+			}
+			if !strings.Contains(stderr.String(), tt.stderr) {
+				t.Errorf("expected the errors to contain %q, got:\n%s", tt.stderr, stderr.String())
+			}
+			if tt.code == ExitOK && slices.Contains(tt.args, "json") && !json.Valid(stdout.Bytes()) {
+				t.Errorf("expected JSON, got:\n%s", stdout.String())
+			}
+		})
+	}
+
+	var stdout, stderr bytes.Buffer
+	if code := Run([]string{"standings", "--input", undated}, &stdout, &stderr); code != ExitUsage ||
+		!strings.Contains(stderr.String(), "give the season with --season") {
+		t.Errorf("expected undated rows without --season to be a usage error, got %d: %s", code, stderr.String())
+	}
+	stderr.Reset()
+	if code := Run([]string{"standings", "--input", filepath.Join(t.TempDir(), "missing.csv")}, &stdout, &stderr); code != ExitError {
+		t.Errorf("expected a missing input to exit with %d, got %d", ExitError, code)
+	}
+	stderr.Reset()
+	if code := Run([]string{"kickoff"}, &stdout, &stderr); code != ExitUsage || !strings.Contains(stderr.String(), `unknown command "kickoff"`) {
+		t.Errorf("expected an unknown command to be a usage error, got %d: %s", code, stderr.String())
+	}
+}
@@ -0,0 +1,695 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"math"
+	"nfl-app/internal/draft"
+	"nfl-app/internal/entry"
+	"nfl-app/internal/entrysort"
+	"nfl-app/internal/playoff"
+	"nfl-app/internal/simulate"
+	"nfl-app/internal/standings"
+	"nfl-app/internal/stats"
+	"nfl-app/internal/team"
+	"nfl-app/internal/whatif"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// teamRow is the JSON shape of a team in the standings
+type teamRow struct {
+	Team          string  `json:"team"`
+	Abbreviation  string  `json:"abbreviation"`
+	Conference    string  `json:"conference"`
+	Division      string  `json:"division"`
+	Wins          int     `json:"wins"`
+	Losses        int     `json:"losses"`
+	Ties          int     `json:"ties"`
+	WinPercentage float64 `json:"winPercentage"`
+	PointsFor     int     `json:"pointsFor"`
+	PointsAgainst int     `json:"pointsAgainst"`
+	DivisionRank  int     `json:"divisionRank"`
+	Seed          int     `json:"seed"`
+	Clincher      string  `json:"clincher,omitempty"`
+
+	StrengthOfSchedule          float64 `json:"strengthOfSchedule"`
+	RemainingStrengthOfSchedule float64 `json:"remainingStrengthOfSchedule"`
+	ProjectedStrengthOfSchedule float64 `json:"projectedStrengthOfSchedule,omitempty"`
+
+	SRS          float64 `json:"srs"`
+	ExpectedWins float64 `json:"expectedWins"`
+	Luck         float64 `json:"luck"`
+
+	YardsGained                 int     `json:"yardsGained"`
+	YardsAllowed                int     `json:"yardsAllowed"`
+	YardsGainedPerGame          float64 `json:"yardsGainedPerGame"`
+	YardsAllowedPerGame         float64 `json:"yardsAllowedPerGame"`
+	TurnoversCommitted          int     `json:"turnoversCommitted"`
+	TurnoversForced             int     `json:"turnoversForced"`
+	TurnoverDifferential        int     `json:"turnoverDifferential"`
+	TurnoverDifferentialPerGame float64 `json:"turnoverDifferentialPerGame"`
+}
+
+func newTeamRow(e entry.Entry) teamRow {
+	r := e.Stats.Record
+	return teamRow{
+		Team:          e.Team.Name,
+		Abbreviation:  e.Team.Abbreviation,
+		Conference:    e.Team.Conference,
+		Division:      e.Team.Division,
+		Wins:          r.Wins(),
+		Losses:        r.Losses(),
+		Ties:          r.Ties(),
+		WinPercentage: r.WinPercentage(),
+		PointsFor:     e.Stats.Points.For,
+		PointsAgainst: e.Stats.Points.Against,
+		DivisionRank:  e.Stats.DivisionRank,
+		Seed:          e.Stats.Seed,
+		Clincher:      e.Stats.Clincher,
+
+		StrengthOfSchedule:          e.Stats.StrengthOfSchedule,
+		RemainingStrengthOfSchedule: e.Stats.RemainingStrengthOfSchedule,
+		ProjectedStrengthOfSchedule: e.Stats.ProjectedStrengthOfSchedule,
+
+		SRS:          e.Stats.SRS,
+		ExpectedWins: e.Stats.ExpectedWins,
+		Luck:         e.Stats.Luck,
+
+		YardsGained:                 e.Stats.Yards.Gained,
+		YardsAllowed:                e.Stats.Yards.Allowed,
+		YardsGainedPerGame:          e.Stats.PerGame(e.Stats.Yards.Gained),
+		YardsAllowedPerGame:         e.Stats.PerGame(e.Stats.Yards.Allowed),
+		TurnoversCommitted:          e.Stats.Turnovers.Committed,
+		TurnoversForced:             e.Stats.Turnovers.Forced,
+		TurnoverDifferential:        e.Stats.Turnovers.Differential(),
+		TurnoverDifferentialPerGame: e.Stats.PerGame(e.Stats.Turnovers.Differential()),
+	}
+}
+
+// recordString formats a record as wins-losses, adding ties only when there are any
+func recordString(r stats.Record) string {
+	if r.Ties() > 0 {
+		return fmt.Sprintf("%d-%d-%d", r.Wins(), r.Losses(), r.Ties())
+	}
+	return fmt.Sprintf("%d-%d", r.Wins(), r.Losses())
+}
+
+func pctString(r stats.Record) string {
+	return fractionString(r.WinPercentage())
+}
+
+// fractionString formats a percentage the way the standings do, as .667
+func fractionString(f float64) string {
+	return strings.TrimPrefix(fmt.Sprintf("%.3f", f), "0")
+}
+
+func streakString(streak int) string {
+	switch {
+	case streak > 0:
+		return fmt.Sprintf("W%d", streak)
+	case streak < 0:
+		return fmt.Sprintf("L%d", -streak)
+	}
+	return "-"
+}
+
+// teamLabel is the team name with its clinch indicator, as printed in the standings
+func teamLabel(e entry.Entry) string {
+	if e.Stats.Clincher == "" {
+		return e.Team.Name
+	}
+	return fmt.Sprintf("%s-%s", e.Stats.Clincher, e.Team.Name)
+}
+
+// conferences resolves the --conference flag, which may be empty for both conferences
+func conferences(flagValue string) ([]string, error) {
+	if flagValue == "" {
+		return team.Conferences, nil
+	}
+	if conf, ok := team.LookupConference(flagValue); ok {
+		return []string{conf}, nil
+	}
+	return nil, usagef("unknown conference %q, expected AFC or NFC", flagValue)
+}
+
+func runStandings(env *env, args []string) error {
+	fs := newFlagSet(env)
+	var src source
+	var out output
+	src.register(fs)
+	out.register(fs)
+	conference := fs.String("conference", "", "only show the `AFC` or NFC")
+	sortBy := fs.String("sort", "", "list every team in one table ordered by `column`, such as srs or turnoverDifferential")
+
+	if err := parseNoArgs(fs, args); err != nil {
+		return err
+	}
+	confs, err := conferences(*conference)
+	if err != nil {
+		return err
+	}
+	var column stats.Column
+	if *sortBy != "" {
+		var ok bool
+		if column, ok = stats.LookupColumn(*sortBy); !ok {
+			return oneOf("sort", *sortBy, stats.ColumnNames()...)
+		}
+	}
+	if err := out.validate(); err != nil {
+		return err
+	}
+
+	season, err := src.load()
+	if err != nil {
+		return err
+	}
+	sched := season.schedule()
+	st, err := standings.Compute(sched)
+	if err != nil {
+		return err
+	}
+	st, err = standings.ProjectStrengthOfSchedule(st, season.year)
+	if err != nil {
+		return err
+	}
+
+	numbers, err := standings.MagicNumbers(sched, st)
+	if err != nil {
+		return err
+	}
+	magic := make(map[string]map[string]standings.Number)
+	for _, n := range numbers {
+		if magic[n.Team] == nil {
+			magic[n.Team] = make(map[string]standings.Number)
+		}
+		magic[n.Team][n.Goal] = n
+	}
+
+	// Teams are grouped by division, or listed together when sorting
+	type group struct {
+		title   string
+		entries []entry.Entry
+	}
+	groups := make([]group, 0)
+	if *sortBy != "" {
+		entries := make([]entry.Entry, 0)
+		for _, e := range st.Entries {
+			if slices.Contains(confs, e.Team.Conference) {
+				entries = append(entries, e)
+			}
+		}
+		groups = append(groups, group{"Sorted by " + column.Header, entry.SortByColumn(entries, column)})
+	} else {
+		for _, div := range team.Divisions {
+			entries := st.Division(div)
+			if len(entries) > 0 && slices.Contains(confs, entries[0].Team.Conference) {
+				groups = append(groups, group{div, entries})
+			}
+		}
+	}
+
+	// The sorted column is added at the end when it is not one of the usual ones
+	header := []string{"Team", "W", "L", "T", "Pct", "PF", "PA", "Diff", "YPG", "YAPG", "TO +/-", "Div", "Conf", "Strk",
+		"SOS", "Rem SOS", "Next SOS", "Div #", "Berth #"}
+	extra := *sortBy != "" && !slices.Contains(header, column.Header)
+	if extra {
+		header = append(header, column.Header)
+	}
+
+	r := report{}
+	rows := make([]teamRow, 0)
+	for _, g := range groups {
+		t := &table{title: g.title, header: header}
+		for _, e := range g.entries {
+			rec := e.Stats.Record
+			cells := []any{teamLabel(e), rec.Wins(), rec.Losses(), rec.Ties(), pctString(rec),
+				e.Stats.Points.For, e.Stats.Points.Against, e.Stats.Points.Differential(),
+				fmt.Sprintf("%.1f", e.Stats.PerGame(e.Stats.Yards.Gained)), fmt.Sprintf("%.1f", e.Stats.PerGame(e.Stats.Yards.Allowed)),
+				fmt.Sprintf("%+d", e.Stats.Turnovers.Differential()),
+				recordString(e.Stats.DivisionRecord), recordString(e.Stats.ConferenceRecord), streakString(e.Stats.Streak),
+				fractionString(e.Stats.StrengthOfSchedule), fractionString(e.Stats.RemainingStrengthOfSchedule),
+				fractionString(e.Stats.ProjectedStrengthOfSchedule),
+				numberString(magic[e.Team.Name][standings.GoalDivision]),
+				numberString(magic[e.Team.Name][standings.GoalPlayoffBerth])}
+			if extra {
+				cells = append(cells, columnString(column, e.Stats))
+			}
+			t.add(cells...)
+			rows = append(rows, newTeamRow(e))
+		}
+		r.tables = append(r.tables, t)
+	}
+	r.data = rows
+	r.notes = []string{"YPG and YAPG are yards gained and allowed per game, TO +/- is turnovers forced less turnovers committed\n" +
+		"SOS is the opponents' combined record, Rem SOS that of the opponents still to play and Next SOS that of next season's\n" +
+		"Div # and Berth # are magic numbers (M) for leaders and elimination numbers (E) for the rest,\n" +
+		"counting wins by the team and losses by the rival, with a tie worth half of either and the rival winning any tiebreaker,\n" +
+		"and - once the clinch indicator settles it\n" +
+		"x clinched playoff berth, y clinched division, z clinched first round bye, * clinched home field throughout, e eliminated"}
+	if len(sched.RemainingGames()) > 0 {
+		r.notes = append(r.notes, "The season is not over, so Next SOS is provisional: next season's opponents follow the final division places")
+	}
+
+	return out.write(env.stdout, r)
+}
+
+// columnString formats a stat of a column, with two decimals unless it is a whole number
+func columnString(c stats.Column, s stats.Stats) string {
+	v := c.Value(&s)
+	if v == math.Trunc(v) {
+		return fmt.Sprintf("%.0f", v)
+	}
+	return fmt.Sprintf("%.2f", v)
+}
+
+// numberString formats a magic or elimination number, leaving it out once it has reached zero
+func numberString(n standings.Number) string {
+	if n.Value == 0 {
+		return "-"
+	}
+	if n.Elimination {
+		return fmt.Sprintf("E%d", n.Value)
+	}
+	return fmt.Sprintf("M%d", n.Value)
+}
+
+func runSeed(env *env, args []string) error {
+	fs := newFlagSet(env)
+	var src source
+	var out output
+	src.register(fs)
+	out.register(fs)
+	conference := fs.String("conference", "", "only show the `AFC` or NFC")
+	week := fs.Int("week", 0, "show the seeds as they stood after `week` N, defaults to the latest")
+
+	if err := parseNoArgs(fs, args); err != nil {
+		return err
+	}
+	confs, err := conferences(*conference)
+	if err != nil {
+		return err
+	}
+	if err := out.validate(); err != nil {
+		return err
+	}
+	if *week < 0 {
+		return usagef("--week must be positive")
+	}
+
+	season, err := src.load()
+	if err != nil {
+		return err
+	}
+	sched := season.schedule()
+	if *week == 0 {
+		*week = len(sched.Weeks)
+	}
+	st, err := standings.StandingsAsOf(sched, *week)
+	if err != nil {
+		return err
+	}
+
+	return out.write(env.stdout, seedReport(st, confs))
+}
+
+// seedReport lists every team of each conference in seed order, marking where the playoff line falls
+func seedReport(st standings.Standings, confs []string) report {
+	r := report{}
+	data := make(map[string][]teamRow)
+	for _, conf := range confs {
+		t := &table{
+			title:  team.ConferenceAbbreviation(conf),
+			header: []string{"Seed", "Team", "Record", "Pct", "Division", "Div", "Conf"},
+		}
+		for _, e := range st.Conference(conf) {
+			seed := strconv.Itoa(e.Stats.Seed)
+			if e.Stats.Seed > playoff.SeedsPerConference {
+				seed = "-"
+			}
+			t.add(seed, teamLabel(e), recordString(e.Stats.Record), pctString(e.Stats.Record), e.Team.Division,
+				recordString(e.Stats.DivisionRecord), recordString(e.Stats.ConferenceRecord))
+			data[team.ConferenceAbbreviation(conf)] = append(data[team.ConferenceAbbreviation(conf)], newTeamRow(e))
+		}
+		r.tables = append(r.tables, t)
+	}
+	r.data = data
+	return r
+}
+
+func runTiebreak(env *env, args []string) error {
+	fs := newFlagSet(env)
+	var src source
+	var out output
+	src.register(fs)
+	out.register(fs)
+
+	names, err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(names) < 2 {
+		return usagef("give at least two teams")
+	}
+	if err := out.validate(); err != nil {
+		return err
+	}
+
+	teams := make([]team.Team, 0, len(names))
+	for _, name := range names {
+		t, ok := team.Lookup(name)
+		if !ok {
+			return usagef("unknown team %q", name)
+		}
+		teams = append(teams, t)
+	}
+
+	season, err := src.load()
+	if err != nil {
+		return err
+	}
+	st, err := standings.Rank(season.schedule())
+	if err != nil {
+		return err
+	}
+
+	entries := entry.FilterEntries(st.Entries, teams)
+	sorted, trace, err := entrysort.SortEntriesTrace(entries, st.TeamSchedules)
+	if err != nil {
+		return err
+	}
+
+	t := &table{header: []string{"Rank", "Team", "Record", "Pct"}}
+	order := make([]string, len(sorted))
+	for i, e := range sorted {
+		t.add(i+1, e.Team.Name, recordString(e.Stats.Record), pctString(e.Stats.Record))
+		order[i] = e.Team.Name
+	}
+
+	return out.write(env.stdout, report{
+		tables: []*table{t},
+		data: struct {
+			Order []string          `json:"order"`
+			Steps []*entrysort.Step `json:"steps"`
+		}{order, trace.Steps},
+		notes: []string{trace.String()},
+	})
+}
+
+func runDraftOrder(env *env, args []string) error {
+	fs := newFlagSet(env)
+	var src source
+	var out output
+	src.register(fs)
+	out.register(fs)
+
+	if err := parseNoArgs(fs, args); err != nil {
+		return err
+	}
+	if err := out.validate(); err != nil {
+		return err
+	}
+
+	season, err := src.load()
+	if err != nil {
+		return err
+	}
+	ds, err := draft.FromSeason(season.year, season.schedule(), playoff.GamesFromRows(season.playoffRows()))
+	if err != nil {
+		return err
+	}
+
+	type pickRow struct {
+		Pick int `json:"pick"`
+		teamRow
+		StrengthOfSchedule float64 `json:"strengthOfSchedule"`
+		Exit               string  `json:"exit,omitempty"`
+	}
+
+	t := &table{header: []string{"Pick", "Team", "Record", "SOS", "Playoffs"}}
+	rows := make([]pickRow, len(ds.Order))
+	for i, e := range ds.Order {
+		exit := draft.ExitName(ds.Exits[e.Team.Name], ds.Final)
+		t.add(i+1, e.Team.Name, recordString(e.Stats.Record), fmt.Sprintf("%.3f", e.Stats.StrengthOfSchedule), exit)
+		rows[i] = pickRow{
+			Pick:               i + 1,
+			teamRow:            newTeamRow(e),
+			StrengthOfSchedule: e.Stats.StrengthOfSchedule,
+			Exit:               exit,
+		}
+	}
+
+	r := report{tables: []*table{t}, data: rows}
+	if !ds.Final {
+		r.notes = []string{"The season is not over, so this order is provisional"}
+	}
+	return out.write(env.stdout, r)
+}
+
+func runSimulate(env *env, args []string) error {
+	fs := newFlagSet(env)
+	var src source
+	var out output
+	src.register(fs)
+	out.register(fs)
+	iterations := fs.Int("iterations", 1000, "number of seasons to simulate")
+	seed := fs.Uint64("seed", 1, "random seed, the same seed gives the same odds")
+	workers := fs.Int("workers", 0, "number of seasons simulated at once, defaults to the number of CPUs")
+	modelName := fs.String("model", "coin", "how remaining games are decided: `coin` flips, elo ratings from the results so far, or point spreads")
+	prior := fs.String("prior", "", "previous season's CSV or JSON `file` of scraped rows, or saved HTML games page, to start elo ratings from")
+	spreads := fs.String("spreads", "", "CSV `file` of AWAY,HOME,SPREAD lines for the spread model, the spread being the home team's expected margin")
+
+	if err := parseNoArgs(fs, args); err != nil {
+		return err
+	}
+	if *iterations < 1 {
+		return usagef("--iterations must be positive")
+	}
+	if err := oneOf("model", *modelName, "coin", "elo", "spread"); err != nil {
+		return err
+	}
+	if *prior != "" && *modelName != "elo" {
+		return usagef("--prior only applies to the elo model")
+	}
+	if (*spreads != "") != (*modelName == "spread") {
+		return usagef("--spreads is needed for the spread model, and only applies to it")
+	}
+	if err := out.validate(); err != nil {
+		return err
+	}
+
+	season, err := src.load()
+	if err != nil {
+		return err
+	}
+	sched := season.schedule()
+	var model simulate.WinModel = simulate.CoinFlip{}
+	note := "games decided by coin flips"
+	switch *modelName {
+	case "elo":
+		ratings, start, err := rateElo(sched, *prior)
+		if err != nil {
+			return err
+		}
+		model = simulate.NewEloModel(ratings.Final())
+		note = "games decided by Elo ratings from this season's results, " + start
+	case "spread":
+		lines, err := readSpreads(*spreads)
+		if err != nil {
+			return err
+		}
+		missing := 0
+		for _, sg := range sched.RemainingGames() {
+			if _, ok := lines[simulate.SpreadKey(sg.Game.Home, sg.Game.Away)]; !ok {
+				missing++
+			}
+		}
+		model = simulate.NewSpreadModel(lines)
+		note = fmt.Sprintf("games decided by the point spreads in %s, with pick'em for games without one, %d of the remaining regular season games",
+			*spreads, missing)
+	}
+	result, err := simulate.Run(sched, simulate.Options{
+		Iterations: *iterations,
+		Seed:       *seed,
+		Workers:    *workers,
+		Model:      model,
+	})
+	if err != nil {
+		return err
+	}
+
+	type oddsRow struct {
+		Team          string    `json:"team"`
+		Playoffs      float64   `json:"playoffs"`
+		Division      float64   `json:"division"`
+		FirstRoundBye float64   `json:"firstRoundBye"`
+		Seeds         []float64 `json:"seeds"`
+		DraftSlots    []float64 `json:"draftSlots"`
+	}
+
+	r := report{}
+	rows := make([]oddsRow, 0, len(result.Teams))
+	for _, conf := range team.Conferences {
+		odds := make([]simulate.Odds, 0)
+		for _, o := range result.Teams {
+			if o.Team.Conference == conf {
+				odds = append(odds, o)
+			}
+		}
+		sort.Slice(odds, func(i, j int) bool {
+			if odds[i].Playoffs != odds[j].Playoffs {
+				return odds[i].Playoffs > odds[j].Playoffs
+			}
+			return odds[i].Team.Name < odds[j].Team.Name
+		})
+
+		t := &table{
+			title:  team.ConferenceAbbreviation(conf),
+			header: []string{"Team", "Playoffs", "Division", "Bye", "Top 5 pick", "#1 pick"},
+		}
+		for _, o := range odds {
+			top5 := 0.0
+			for _, share := range o.DraftSlots[:5] {
+				top5 += share
+			}
+			t.add(o.Team.Name, percent(o.Playoffs), percent(o.Division), percent(o.FirstRoundBye),
+				percent(top5), percent(o.DraftSlots[0]))
+			rows = append(rows, oddsRow{o.Team.Name, o.Playoffs, o.Division, o.FirstRoundBye, o.Seeds, o.DraftSlots})
+		}
+		r.tables = append(r.tables, t)
+	}
+	r.data = struct {
+		Iterations int       `json:"iterations"`
+		Teams      []oddsRow `json:"teams"`
+	}{result.Iterations, rows}
+	r.notes = []string{fmt.Sprintf("%d simulated seasons, %s", result.Iterations, note)}
+
+	return out.write(env.stdout, r)
+}
+
+func runWhatIf(env *env, args []string) error {
+	fs := newFlagSet(env)
+	var src source
+	var out output
+	src.register(fs)
+	out.register(fs)
+	showTrace := fs.Bool("trace", false, "print the tiebreakers used to seed each conference")
+	fs.Usage = whatIfUsage(env, fs)
+
+	specs, err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+	if err := out.validate(); err != nil {
+		return err
+	}
+
+	picks := make([]whatif.Pick, len(specs))
+	for i, spec := range specs {
+		picks[i], err = parsePick(spec)
+		if err != nil {
+			return err
+		}
+	}
+
+	season, err := src.load()
+	if err != nil {
+		return err
+	}
+	result, err := whatif.Evaluate(season.schedule(), picks)
+	if err != nil {
+		return err
+	}
+
+	r := seedReport(result.Standings, team.Conferences)
+	if *showTrace {
+		for _, conf := range team.Conferences {
+			r.notes = append(r.notes, fmt.Sprintf("%s seeding\n%s", team.ConferenceAbbreviation(conf), result.Traces[conf]))
+		}
+	}
+	return out.write(env.stdout, r)
+}
+
+func whatIfUsage(env *env, fs *flag.FlagSet) func() {
+	return func() {
+		fmt.Fprintln(env.stderr, "usage: nfl-app whatif [flags] PICK...")
+		fmt.Fprintln(env.stderr)
+		fmt.Fprintln(env.stderr, "Each PICK is [WEEK:]WINNER>LOSER[/SCORE-SCORE], or TEAM=TEAM[/SCORE-SCORE] for a tie,")
+		fmt.Fprintln(env.stderr, "with teams given by name, abbreviation or nickname and scores winner first.")
+		fmt.Fprintln(env.stderr, "For example: BUF>MIA, 18:KC>LV/31-13, bears=packers")
+		fmt.Fprintln(env.stderr)
+		fmt.Fprintln(env.stderr, "flags:")
+		fs.PrintDefaults()
+	}
+}
+
+// parsePick parses a pick in the form [WEEK:]WINNER>LOSER[/SCORE-SCORE] or [WEEK:]TEAM=TEAM[/SCORE-SCORE]
+func parsePick(spec string) (whatif.Pick, error) {
+	var p whatif.Pick
+	rest := spec
+
+	if week, after, ok := strings.Cut(rest, ":"); ok {
+		w, err := strconv.Atoi(week)
+		if err != nil || w < 1 {
+			return p, usagef("pick %q: bad week %q", spec, week)
+		}
+		p.Week = w
+		rest = after
+	}
+
+	rest, score, scored := strings.Cut(rest, "/")
+
+	sep := ">"
+	if strings.Contains(rest, "=") {
+		sep = "="
+		p.Tie = true
+	}
+	first, second, ok := strings.Cut(rest, sep)
+	if !ok {
+		return p, usagef("pick %q: expected WINNER>LOSER or TEAM=TEAM", spec)
+	}
+
+	t1, ok := team.Lookup(strings.TrimSpace(first))
+	if !ok {
+		return p, usagef("pick %q: unknown team %q", spec, first)
+	}
+	t2, ok := team.Lookup(strings.TrimSpace(second))
+	if !ok {
+		return p, usagef("pick %q: unknown team %q", spec, second)
+	}
+
+	// Apply sorts out which team is at home, so the first team is taken as home here
+	p.Home, p.Away = t1.Name, t2.Name
+	if !p.Tie {
+		p.Winner = t1.Name
+	}
+
+	if scored {
+		s1, s2, ok := strings.Cut(score, "-")
+		h, err1 := strconv.Atoi(s1)
+		a, err2 := strconv.Atoi(s2)
+		if !ok || err1 != nil || err2 != nil {
+			return p, usagef("pick %q: bad score %q", spec, score)
+		}
+		p.HomeScore, p.AwayScore = h, a
+	}
+
+	if err := p.Validate(); err != nil {
+		return p, usageError{err: err}
+	}
+	return p, nil
+}
+
+// parseNoArgs parses the flags of a command that takes no positional arguments
+func parseNoArgs(fs *flag.FlagSet, args []string) error {
+	positional, err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 0 {
+		return usagef("unexpected arguments %s", strings.Join(positional, " "))
+	}
+	return nil
+}
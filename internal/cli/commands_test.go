@@ -0,0 +1,36 @@
+package cli
+
+import (
+	"nfl-app/internal/team"
+	"nfl-app/internal/whatif"
+	"testing"
+)
+
+func TestParsePick(t *testing.T) {
+	tests := []struct {
+		spec string
+		want whatif.Pick
+	}{
+		{"BUF>MIA", whatif.Pick{Home: team.BuffaloBills.Name, Away: team.MiamiDolphins.Name, Winner: team.BuffaloBills.Name}},
+		{"18:kc>Raiders/31-13", whatif.Pick{Week: 18, Home: team.KansasCityChiefs.Name, Away: team.LasVegasRaiders.Name,
+			Winner: team.KansasCityChiefs.Name, HomeScore: 31, AwayScore: 13}},
+		{"bears=packers", whatif.Pick{Home: team.ChicagoBears.Name, Away: team.GreenBayPackers.Name, Tie: true}},
+	}
+
+	for _, tt := range tests {
+		got, err := parsePick(tt.spec)
+		if err != nil {
+			t.Errorf("%s: %v", tt.spec, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%s: got %+v, want %+v", tt.spec, got, tt.want)
+		}
+	}
+
+	for _, bad := range []string{"BUF", "BUF>XYZ", "0:BUF>MIA", "BUF>MIA/10-20", "BUF=MIA/10-13", "BUF>BUF"} {
+		if _, err := parsePick(bad); err == nil {
+			t.Errorf("%s: expected an error", bad)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"fmt"
+	"nfl-app/internal/playoff"
+	"nfl-app/internal/standings"
+	"nfl-app/internal/team"
+	"sort"
+	"strconv"
+)
+
+func runHistory(env *env, args []string) error {
+	fs := newFlagSet(env)
+	var src source
+	var out output
+	src.register(fs)
+	out.register(fs)
+	conference := fs.String("conference", "", "only show the `AFC` or NFC")
+
+	if err := parseNoArgs(fs, args); err != nil {
+		return err
+	}
+	confs, err := conferences(*conference)
+	if err != nil {
+		return err
+	}
+	if err := out.validate(); err != nil {
+		return err
+	}
+
+	season, err := src.load()
+	if err != nil {
+		return err
+	}
+	tl, err := standings.NewTimeline(season.schedule())
+	if err != nil {
+		return err
+	}
+
+	weeks := tl.Weeks()
+	if weeks == 0 {
+		return fmt.Errorf("no games have been played in the %d season", season.year)
+	}
+
+	r := report{}
+	data := make(map[string][]standings.Snapshot)
+	for _, conf := range confs {
+		t := &table{title: team.ConferenceAbbreviation(conf), header: []string{"Team"}}
+		for week := 1; week <= weeks; week++ {
+			t.header = append(t.header, strconv.Itoa(week))
+		}
+
+		// Teams in the order of the latest seeds
+		teams := make([]string, 0)
+		for _, tm := range team.NFLTeams {
+			if tm.Conference == conf {
+				teams = append(teams, tm.Name)
+			}
+		}
+		sort.Slice(teams, func(i, j int) bool {
+			return tl[teams[i]][weeks-1].Seed < tl[teams[j]][weeks-1].Seed
+		})
+
+		for _, name := range teams {
+			cells := []any{name}
+			for _, snap := range tl[name] {
+				cells = append(cells, snapshotString(snap))
+			}
+			t.add(cells...)
+			data[name] = tl[name]
+		}
+		r.tables = append(r.tables, t)
+	}
+	r.data = data
+	r.notes = []string{"Seed after each week, - outside the playoffs\n" +
+		"x clinched playoff berth, y clinched division, z clinched first round bye, * clinched home field throughout, e eliminated"}
+
+	return out.write(env.stdout, r)
+}
+
+// snapshotString is a team's seed after a week with its clinch indicator, or - when it is outside the playoffs
+// Eliminated teams only show the indicator
+func snapshotString(s standings.Snapshot) string {
+	if s.Seed > playoff.SeedsPerConference {
+		if s.Clincher == "" {
+			return "-"
+		}
+		return s.Clincher
+	}
+	return fmt.Sprintf("%s%d", s.Clincher, s.Seed)
+}
@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"nfl-app/internal/standings"
+	"nfl-app/internal/team"
+	"strconv"
+	"strings"
+)
+
+func runOpponents(env *env, args []string) error {
+	fs := newFlagSet(env)
+	var src source
+	var out output
+	src.register(fs)
+	out.register(fs)
+	conference := fs.String("conference", "", "only show the `AFC` or NFC")
+
+	if err := parseNoArgs(fs, args); err != nil {
+		return err
+	}
+	confs, err := conferences(*conference)
+	if err != nil {
+		return err
+	}
+	if err := out.validate(); err != nil {
+		return err
+	}
+
+	season, err := src.load()
+	if err != nil {
+		return err
+	}
+	sched := season.schedule()
+	st, err := standings.Rank(sched)
+	if err != nil {
+		return err
+	}
+	opponents, err := standings.NextSeasonOpponents(st, season.year)
+	if err != nil {
+		return err
+	}
+
+	r := report{}
+	data := make(map[string]standings.Opponents)
+	for _, conf := range confs {
+		for _, div := range team.ConferenceDivisions(conf) {
+			t := &table{title: div, header: []string{"Team", "Place", "Home", "Away"}}
+			for _, e := range st.Division(div) {
+				opp := opponents[e.Team.Name]
+				t.add(e.Team.Name, e.Stats.DivisionRank, abbreviations(opp.Home), abbreviations(opp.Away))
+				data[e.Team.Name] = opp
+			}
+			r.tables = append(r.tables, t)
+		}
+	}
+	r.data = data
+	r.notes = []string{"Opponents in the " + strconv.Itoa(season.year+1) + " season, from this season's division finishes"}
+	if len(sched.RemainingGames()) > 0 {
+		r.notes = append(r.notes, "The season is not over, so these opponents are provisional")
+	}
+
+	return out.write(env.stdout, r)
+}
+
+func abbreviations(teams []team.Team) string {
+	out := make([]string, len(teams))
+	for i, t := range teams {
+		out[i] = t.Abbreviation
+	}
+	return strings.Join(out, " ")
+}
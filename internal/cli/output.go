@@ -0,0 +1,136 @@
+package cli
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// Output formats
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+	formatCSV   = "csv"
+)
+
+// output holds the output format flag shared by every command
+type output struct {
+	format string
+}
+
+func (o *output) register(fs *flag.FlagSet) {
+	fs.StringVar(&o.format, "format", formatTable, "output `format`: table, json or csv")
+}
+
+func (o *output) validate() error {
+	return oneOf("format", o.format, formatTable, formatJSON, formatCSV)
+}
+
+// table is a titled block of rows, printed as aligned columns or CSV
+type table struct {
+	title  string
+	header []string
+	rows   [][]string
+}
+
+func (t *table) add(cells ...any) {
+	row := make([]string, len(cells))
+	for i, c := range cells {
+		row[i] = fmt.Sprint(c)
+	}
+	t.rows = append(t.rows, row)
+}
+
+// report is the result of a command. Tables are printed for the table and CSV formats, data is encoded for JSON
+type report struct {
+	tables []*table
+	data   any
+
+	// notes are printed after the tables in the table format only
+	notes []string
+}
+
+func (o *output) write(w io.Writer, r report) error {
+	switch o.format {
+	case formatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(r.data)
+	case formatCSV:
+		return writeCSV(w, r.tables)
+	}
+	if err := writeTables(w, r.tables); err != nil {
+		return err
+	}
+	for _, note := range r.notes {
+		fmt.Fprintln(w)
+		fmt.Fprint(w, note)
+		if !strings.HasSuffix(note, "\n") {
+			fmt.Fprintln(w)
+		}
+	}
+	return nil
+}
+
+func writeTables(w io.Writer, tables []*table) error {
+	for i, t := range tables {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		if t.title != "" {
+			fmt.Fprintln(w, t.title)
+		}
+
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, strings.Join(t.header, "\t"))
+		for _, row := range t.rows {
+			fmt.Fprintln(tw, strings.Join(row, "\t"))
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeCSV writes every table one after the other under a single header. When the report has several tables,
+// their titles are added as a leading column so the rows can still be told apart
+func writeCSV(w io.Writer, tables []*table) error {
+	if len(tables) == 0 {
+		return nil
+	}
+
+	titled := len(tables) > 1
+	cw := csv.NewWriter(w)
+
+	header := tables[0].header
+	if titled {
+		header = append([]string{"Group"}, header...)
+	}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+
+	for _, t := range tables {
+		for _, row := range t.rows {
+			if titled {
+				row = append([]string{t.title}, row...)
+			}
+			if err := cw.Write(row); err != nil {
+				return err
+			}
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// percent formats a share as a percentage with one decimal
+func percent(share float64) string {
+	return fmt.Sprintf("%.1f%%", 100*share)
+}
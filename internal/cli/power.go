@@ -0,0 +1,141 @@
+package cli
+
+import (
+	"fmt"
+	"maps"
+	"nfl-app/internal/elo"
+	"nfl-app/internal/schedule"
+	"nfl-app/internal/standings"
+	"nfl-app/internal/stats"
+	"nfl-app/internal/team"
+	"slices"
+	"sort"
+	"strings"
+)
+
+func runPower(env *env, args []string) error {
+	fs := newFlagSet(env)
+	var src source
+	var out output
+	src.register(fs)
+	out.register(fs)
+	week := fs.Int("week", 0, "show the rankings as they stood after `week` N, defaults to the latest")
+	model := fs.String("model", "elo", "rate teams with `elo` ratings or the srs, the simple rating system")
+	marginName := fs.String("margin", "points", "margin the srs rates teams on: `points`, yards or turnovers")
+	prior := fs.String("prior", "", "previous season's CSV or JSON `file` of scraped rows, or saved HTML games page, to start elo ratings from")
+
+	if err := parseNoArgs(fs, args); err != nil {
+		return err
+	}
+	if *week < 0 {
+		return usagef("--week must be positive")
+	}
+	if err := oneOf("model", *model, "elo", "srs"); err != nil {
+		return err
+	}
+	if *prior != "" && *model != "elo" {
+		return usagef("--prior only applies to elo ratings")
+	}
+	margins := map[string]stats.Column{"points": stats.PointMargin, "yards": stats.YardMargin, "turnovers": stats.TurnoverMargin}
+	margin, ok := margins[*marginName]
+	if !ok {
+		return oneOf("margin", *marginName, slices.Collect(maps.Keys(margins))...)
+	}
+	if err := out.validate(); err != nil {
+		return err
+	}
+
+	season, err := src.load()
+	if err != nil {
+		return err
+	}
+	sched := season.schedule()
+	if *week == 0 {
+		*week = sched.LastPlayedWeek()
+	}
+	st, err := standings.StandingsAsOf(sched, *week)
+	if err != nil {
+		return err
+	}
+
+	var now, before map[string]float64
+	var format, note string
+	switch *model {
+	case "elo":
+		ratings, start, err := rateElo(sched, *prior)
+		if err != nil {
+			return err
+		}
+		now, before = ratings.Week(*week), ratings.Week(*week-1)
+		format, note = "%.0f", "Elo ratings from this season's results, "+start
+	case "srs":
+		now = schedule.SimpleRatings(st.Entries, st.TeamSchedules, margin)
+		before = map[string]float64{}
+		if *week > 1 {
+			prev, err := standings.Rank(sched.Through(*week - 1))
+			if err != nil {
+				return err
+			}
+			before = schedule.SimpleRatings(prev.Entries, prev.TeamSchedules, margin)
+		}
+		format, note = "%.1f", fmt.Sprintf("SRS ratings, the average %s margin per game adjusted for the opponents played", strings.TrimSuffix(*marginName, "s"))
+	}
+
+	type powerRow struct {
+		Rank   int     `json:"rank"`
+		Team   string  `json:"team"`
+		Rating float64 `json:"rating"`
+		Change float64 `json:"change"`
+		Record string  `json:"record"`
+	}
+
+	teams := make([]string, 0, len(team.NFLTeams))
+	for _, t := range team.NFLTeams {
+		teams = append(teams, t.Name)
+	}
+	sort.SliceStable(teams, func(i, j int) bool { return now[teams[i]] > now[teams[j]] })
+
+	r := report{}
+	t := &table{title: fmt.Sprintf("Power rankings after week %d", *week), header: []string{"#", "Team", "Rating", "Change", "Record"}}
+	rows := make([]powerRow, 0, len(teams))
+	for i, name := range teams {
+		record := "0-0"
+		if e, ok := st.Entry(name); ok {
+			record = recordString(e.Stats.Record)
+		}
+		row := powerRow{Rank: i + 1, Team: name, Rating: now[name], Change: now[name] - before[name], Record: record}
+		t.add(row.Rank, name, fmt.Sprintf(format, row.Rating), fmt.Sprintf("%+"+format[1:], row.Change), record)
+		rows = append(rows, row)
+	}
+	r.tables = []*table{t}
+	r.data = rows
+	r.notes = []string{note + ". Change is since the week before"}
+
+	return out.write(env.stdout, r)
+}
+
+// rateElo rates the season's results with the default Elo options, along with a note on where the ratings started
+// Given the previous season's results in prior, every team starts from its final rating regressed towards the mean
+func rateElo(sched schedule.Schedule, prior string) (elo.Season, string, error) {
+	opts := elo.DefaultOptions()
+	if prior == "" {
+		return elo.Rate(sched, nil, opts), fmt.Sprintf("every team starting at %.0f", opts.Initial), nil
+	}
+
+	rows, err := readRows(prior)
+	if err != nil {
+		return elo.Season{}, "", err
+	}
+	if err := validateRows(rows); err != nil {
+		return elo.Season{}, "", err
+	}
+	previous := season{rows: rows}.schedule()
+	if previous.LastPlayedWeek() == 0 {
+		return elo.Season{}, "", fmt.Errorf("no results found in %s", prior)
+	}
+
+	start := elo.Regress(elo.Rate(previous, nil, opts).Final(), opts)
+	note := fmt.Sprintf("starting from last season's final ratings, moved %.0f%% of the way back to %.0f",
+		opts.Regression*100, opts.Initial)
+	return elo.Rate(sched, start, opts), note, nil
+}
@@ -0,0 +1,36 @@
+package cli
+
+import (
+	htmlreport "nfl-app/internal/report"
+	"os"
+)
+
+func runReport(env *env, args []string) error {
+	fs := newFlagSet(env)
+	var src source
+	src.register(fs)
+	output := fs.String("output", "", "HTML `file` to write, defaults to standard output")
+
+	if err := parseNoArgs(fs, args); err != nil {
+		return err
+	}
+
+	season, err := src.load()
+	if err != nil {
+		return err
+	}
+
+	if *output == "" {
+		return htmlreport.Render(env.stdout, season.year, season.schedule())
+	}
+
+	f, err := os.Create(*output)
+	if err != nil {
+		return err
+	}
+	if err := htmlreport.Render(f, season.year, season.schedule()); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
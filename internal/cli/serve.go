@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"nfl-app/internal/scraper"
+	"nfl-app/internal/server"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// shutdownTimeout is how long in-flight requests get to finish once the server is asked to stop
+const shutdownTimeout = 10 * time.Second
+
+func runServe(env *env, args []string) error {
+	fs := newFlagSet(env)
+	addr := fs.String("addr", ":8080", "`address` to listen on")
+	ttl := fs.Duration("ttl", 10*time.Minute, "how long a loaded season is kept before it is loaded again")
+	cacheDir := fs.String("cache-dir", "", "`directory` to cache live scrapes in, one file per season")
+	input := fs.String("input", "", "CSV or JSON `file` of scraped rows to serve instead of scraping, as the --season season")
+	year := fs.Int("season", defaultSeason(time.Now()), "season `year` of the --input file")
+
+	if err := parseNoArgs(fs, args); err != nil {
+		return err
+	}
+	if *input != "" && *cacheDir != "" {
+		return usagef("--input and --cache-dir cannot be used together")
+	}
+
+	load := func(y int) ([]scraper.ScrapedRow, error) {
+		var rows []scraper.ScrapedRow
+		var err error
+		switch {
+		case *input != "" && y != *year:
+			return nil, fmt.Errorf("%w %d, only %d is being served", server.ErrUnknownSeason, y, *year)
+		case *input != "":
+			rows, err = readRows(*input)
+		case *cacheDir != "":
+			rows, err = cachedRows(filepath.Join(*cacheDir, strconv.Itoa(y)+".json"), y)
+		default:
+			rows, err = scraper.ScrapeSeason(strconv.Itoa(y))
+		}
+		if err != nil {
+			return nil, err
+		}
+		return rows, validateRows(rows)
+	}
+
+	srv := &http.Server{
+		Addr:              *addr,
+		Handler:           server.New(load, *ttl).Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	errs := make(chan error, 1)
+	go func() {
+		log.Printf("listening on %s", *addr)
+		errs <- srv.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errs:
+		return err
+	case <-ctx.Done():
+	}
+
+	log.Println("shutting down")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		return err
+	}
+	if err := <-errs; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"nfl-app/internal/schedule"
+	"nfl-app/internal/scraper"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// source holds the data source flags shared by every command
+//
+// By default the season is scraped live. With --cache, the scraped rows are saved to the given file the first time
+// and read back from it afterwards. With --input, rows are read instead from a CSV or JSON file or a saved HTML games page, picked by extension
+// Without --season, a live or cached scrape is of the latest season and an input is of the season its dates fall in
+type source struct {
+	season int
+	cache  string
+	input  string
+}
+
+func (s *source) register(fs *flag.FlagSet) {
+	fs.IntVar(&s.season, "season", 0, "season `year`, for a live scrape or the playoff bracket (default the latest season, or the one the --input dates fall in)")
+	fs.StringVar(&s.cache, "cache", "", "`file` to cache the live scrape in, read instead of scraping when it exists")
+	fs.StringVar(&s.input, "input", "", "CSV or JSON `file` of scraped rows, or a saved HTML games page, to read instead of scraping")
+}
+
+// defaultSeason is the latest season that has started. A season starts in September and ends early the next year
+func defaultSeason(now time.Time) int {
+	if now.Month() < time.September {
+		return now.Year() - 1
+	}
+	return now.Year()
+}
+
+// season is the data a command works from
+type season struct {
+	year int
+	rows []scraper.ScrapedRow
+}
+
+// load reads the season from the chosen source
+func (s *source) load() (season, error) {
+	if s.input != "" && s.cache != "" {
+		return season{}, usagef("--input and --cache cannot be used together")
+	}
+
+	year := s.season
+	if year == 0 && s.input == "" {
+		year = defaultSeason(time.Now())
+	}
+
+	var rows []scraper.ScrapedRow
+	var err error
+	switch {
+	case s.input != "":
+		rows, err = readRows(s.input)
+	case s.cache != "":
+		rows, err = cachedRows(s.cache, year)
+	default:
+		rows, err = scraper.ScrapeSeason(strconv.Itoa(year))
+	}
+	if err != nil {
+		return season{}, err
+	}
+
+	if len(rows) == 0 {
+		if s.input != "" {
+			return season{}, fmt.Errorf("no games found in %s", s.input)
+		}
+		return season{}, fmt.Errorf("no games found for the %d season", year)
+	}
+	if err := validateRows(rows); err != nil {
+		return season{}, err
+	}
+	if year == 0 {
+		var ok bool
+		if year, ok = rowsSeason(rows); !ok {
+			return season{}, usagef("the rows in %s have no dates, give the season with --season", s.input)
+		}
+	}
+	return season{year: year, rows: rows}, nil
+}
+
+// rowsSeason returns the season the first dated row falls in
+func rowsSeason(rows []scraper.ScrapedRow) (int, bool) {
+	for _, row := range rows {
+		if date, err := time.Parse(time.DateOnly, row.Date); err == nil {
+			return defaultSeason(date), true
+		}
+	}
+	return 0, false
+}
+
+// validateRows checks every regular season row has a week schedule.CreateSchedule can place
+func validateRows(rows []scraper.ScrapedRow) error {
+	for _, row := range rows {
+		if scraper.IsPlayoffWeek(row.Week) {
+			continue
+		}
+		if week, err := strconv.Atoi(row.Week); err != nil || week < 1 || week > 18 {
+			return fmt.Errorf("bad week %q in row %s vs %s", row.Week, row.Winner, row.Loser)
+		}
+	}
+	return nil
+}
+
+// schedule returns the regular season schedule, with unplayed games as remaining games
+func (s season) schedule() schedule.Schedule {
+	regular, _ := scraper.SplitPlayoffs(s.rows)
+	return schedule.CreateSchedule(regular)
+}
+
+// playoffRows returns the rows of playoff games, if the source has any
+func (s season) playoffRows() []scraper.ScrapedRow {
+	_, playoffs := scraper.SplitPlayoffs(s.rows)
+	return playoffs
+}
+
+func cachedRows(path string, year int) ([]scraper.ScrapedRow, error) {
+	rows, err := readRows(path)
+	if err == nil {
+		return rows, nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	rows, err = scraper.ScrapeSeason(strconv.Itoa(year))
+	if err != nil {
+		return nil, err
+	}
+	if err := writeRows(path, rows); err != nil {
+		return nil, fmt.Errorf("caching scrape: %w", err)
+	}
+	return rows, nil
+}
+
+func readRows(path string) ([]scraper.ScrapedRow, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".html", ".htm":
+		return scraper.ScrapeFile(path)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		return scraper.ReadCSV(f)
+	}
+
+	rows := make([]scraper.ScrapedRow, 0)
+	if err := json.NewDecoder(f).Decode(&rows); err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	return rows, nil
+}
+
+func writeRows(path string, rows []scraper.ScrapedRow) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		err = scraper.WriteCSV(f, rows)
+	} else {
+		enc := json.NewEncoder(f)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(rows)
+	}
+
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
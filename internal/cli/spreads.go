@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"nfl-app/internal/simulate"
+	"nfl-app/internal/team"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// readSpreads reads point spreads from a CSV file of AWAY,HOME,SPREAD lines, the spread being the home team's
+// expected margin of victory, so -3.5 has the away team favored by three and a half. Teams are given by name,
+// abbreviation or nickname, and a leading header line is skipped
+func readSpreads(path string) (map[string]float64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.FieldsPerRecord = 3
+	r.TrimLeadingSpace = true
+	r.Comment = '#'
+
+	spreads := make(map[string]float64)
+	for line := 1; ; line++ {
+		record, err := r.Read()
+		if err == io.EOF {
+			return spreads, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", path, err)
+		}
+
+		spread, err := strconv.ParseFloat(strings.TrimSpace(record[2]), 64)
+		if err != nil && line == 1 {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s line %d: bad spread %q", path, line, record[2])
+		}
+		away, ok := team.Lookup(strings.TrimSpace(record[0]))
+		if !ok {
+			return nil, fmt.Errorf("%s line %d: unknown team %q", path, line, record[0])
+		}
+		home, ok := team.Lookup(strings.TrimSpace(record[1]))
+		if !ok {
+			return nil, fmt.Errorf("%s line %d: unknown team %q", path, line, record[1])
+		}
+		spreads[simulate.SpreadKey(home.Name, away.Name)] = spread
+	}
+}
@@ -0,0 +1,37 @@
+package cli
+
+import (
+	"fmt"
+	"nfl-app/internal/tui"
+	"os"
+)
+
+func runTUI(env *env, args []string) error {
+	fs := newFlagSet(env)
+	var src source
+	src.register(fs)
+
+	if err := parseNoArgs(fs, args); err != nil {
+		return err
+	}
+
+	// The UI only ever works offline, so never fall back to a live scrape
+	switch {
+	case src.input == "" && src.cache == "":
+		return usagef("give a season with --input or --cache")
+	case src.cache != "":
+		if _, err := os.Stat(src.cache); err != nil {
+			return fmt.Errorf("reading cached season: %w", err)
+		}
+	}
+
+	season, err := src.load()
+	if err != nil {
+		return err
+	}
+	m, err := tui.New(season.schedule())
+	if err != nil {
+		return err
+	}
+	return tui.Run(m, os.Stdin, env.stdout)
+}
@@ -0,0 +1,212 @@
+package clinching
+
+import (
+	"fmt"
+	"nfl-app/internal/schedule"
+	"nfl-app/internal/standings"
+	"nfl-app/internal/stats"
+	"nfl-app/internal/team"
+	"runtime"
+	"slices"
+	"sync"
+)
+
+// Goals a team can clinch
+const (
+	Division      = standings.GoalDivision
+	PlayoffBerth  = standings.GoalPlayoffBerth
+	FirstRoundBye = standings.GoalFirstRoundBye
+)
+
+// Goals in the order they are reported
+var Goals = []string{FirstRoundBye, Division, PlayoffBerth}
+
+// MaxGames is the largest number of games enumerated for a single team and goal. Teams with more games this week that
+// can decide the goal get a scenario marked TooManyGames instead. Every outcome (win, loss or tie) of every game is
+// evaluated, so the work grows as 3^MaxGames
+var MaxGames = 8
+
+// Scenario describes the results this week that clinch a goal for a team
+type Scenario struct {
+	Team string
+	Goal string
+
+	// Conditions holds the alternatives that clinch the goal. Any one of them is enough
+	Conditions []Condition
+
+	// TooManyGames is set when more than MaxGames games this week could decide the goal, so they were not enumerated
+	// and Conditions is empty. The goal may or may not be within reach this week
+	TooManyGames bool
+}
+
+// Condition is a set of results that together clinch a goal
+// An empty condition means the goal is clinched whatever happens this week
+type Condition []Result
+
+// Result is the set of outcomes of a single game that satisfy a condition
+type Result struct {
+	Game     schedule.ScheduledGame
+	Outcomes []standings.Outcome
+}
+
+// Week enumerates every combination of outcomes for the unplayed games of the given week, and returns for each team
+// and goal the conditions under which the goal is clinched by the end of the week. Goals that are already clinched,
+// or that can no longer be clinched this week, are not included. Goals with too many deciding games to enumerate
+// are included with TooManyGames set
+//
+// A combination clinches the goal if the team reaches it whatever the results of the games after it, with the
+// full tiebreaking procedure (see standings.Claim). To keep the enumeration tractable, only the games of the
+// team's contenders for the goal are enumerated (see standings.Claim.Contenders). Every other game this week is left
+// unplayed, and so counts along with the games after it
+func Week(sched schedule.Schedule, week int) ([]Scenario, error) {
+	if week < 1 || week > len(sched.Weeks) {
+		return nil, fmt.Errorf("week %d is outside of the schedule", week)
+	}
+
+	current, err := standings.Clinchers(sched)
+	if err != nil {
+		return nil, err
+	}
+
+	weekGames := make([]schedule.ScheduledGame, 0)
+	for _, sg := range sched.RemainingGames() {
+		if sg.Week == week {
+			weekGames = append(weekGames, sg)
+		}
+	}
+
+	out := make([]Scenario, 0)
+	for _, t := range team.NFLTeams {
+		// Nothing more can be clinched once a team is eliminated or has clinched everything
+		if current[t.Name] == stats.Eliminated || current[t.Name] == stats.ClinchedHomeField {
+			continue
+		}
+
+		for _, goal := range Goals {
+			if achieved(current[t.Name], goal) {
+				continue
+			}
+			games, err := deciding(sched, weekGames, t.Name, goal)
+			if err != nil {
+				return nil, err
+			}
+			if len(games) == 0 {
+				continue
+			}
+			if len(games) > MaxGames {
+				out = append(out, Scenario{Team: t.Name, Goal: goal, TooManyGames: true})
+				continue
+			}
+
+			clinched, err := evaluate(sched, games, t.Name, goal)
+			if err != nil {
+				return nil, err
+			}
+			if !slices.Contains(clinched, true) {
+				continue
+			}
+			out = append(out, Scenario{
+				Team:       t.Name,
+				Goal:       goal,
+				Conditions: minimize(games, clinched),
+			})
+		}
+	}
+
+	return out, nil
+}
+
+// deciding returns the games of the week involving one of the team's contenders for the goal
+func deciding(sched schedule.Schedule, weekGames []schedule.ScheduledGame, teamname, goal string) ([]schedule.ScheduledGame, error) {
+	claim, err := standings.NewClaim(sched, teamname, goal)
+	if err != nil {
+		return nil, err
+	}
+	contenders := claim.Contenders()
+
+	out := make([]schedule.ScheduledGame, 0)
+	for _, sg := range weekGames {
+		if slices.Contains(contenders, sg.Game.Home) || slices.Contains(contenders, sg.Game.Away) {
+			out = append(out, sg)
+		}
+	}
+	return out, nil
+}
+
+// achieved reports whether the clinch indicator covers the goal
+func achieved(indicator, goal string) bool {
+	covers := map[string][]string{
+		FirstRoundBye: {stats.ClinchedHomeField, stats.ClinchedFirstRoundBye},
+		Division:      {stats.ClinchedHomeField, stats.ClinchedFirstRoundBye, stats.ClinchedDivision},
+		PlayoffBerth: {stats.ClinchedHomeField, stats.ClinchedFirstRoundBye, stats.ClinchedDivision,
+			stats.ClinchedPlayoffBerth},
+	}
+	return slices.Contains(covers[goal], indicator)
+}
+
+// evaluate picks every combination of outcomes of the given games and records whether the team has clinched the
+// goal in each. Combinations are indexed in base 3, with the first game as the least significant digit (see
+// outcomeAt)
+func evaluate(sched schedule.Schedule, games []schedule.ScheduledGame, teamname, goal string) ([]bool, error) {
+	total := pow3(len(games))
+	clinched := make([]bool, total)
+
+	workers := min(runtime.NumCPU(), total)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	var firstErr error
+	fail := func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+
+			// A claim can't be shared between goroutines, so each worker makes its own
+			claim, err := standings.NewClaim(sched, teamname, goal)
+			if err != nil {
+				fail(err)
+				return
+			}
+			outcomes := make([]standings.Outcome, len(games))
+			for i := w; i < total; i += workers {
+				for g := range games {
+					outcomes[g] = outcomeAt(i, g)
+				}
+
+				// Each worker writes to distinct indices, so no locking is needed here
+				ok, err := claim.Holds(games, outcomes)
+				if err != nil {
+					fail(err)
+					return
+				}
+				clinched[i] = ok
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return clinched, nil
+}
+
+// outcomeAt returns the outcome of the given game in the combination with the given index
+func outcomeAt(index, game int) standings.Outcome {
+	return standings.Outcomes[(index/pow3(game))%3]
+}
+
+func pow3(n int) int {
+	out := 1
+	for i := 0; i < n; i++ {
+		out *= 3
+	}
+	return out
+}
@@ -0,0 +1,212 @@
+package clinching
+
+import (
+	"nfl-app/internal/game"
+	"nfl-app/internal/schedule"
+	"nfl-app/internal/scraper"
+	"nfl-app/internal/standings"
+	"nfl-app/internal/team"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func teamsIn(division string) []string {
+	var names []string
+	for _, t := range team.NFLTeams {
+		if t.Division == division {
+			names = append(names, t.Name)
+		}
+	}
+	return names
+}
+
+// divisionRace returns a schedule where a is 4-1 and swept b, which is 3-2, with two weeks left. Every other game is
+// against an NFC team
+func divisionRace() (sched schedule.Schedule, a, b string) {
+	east := teamsIn(team.AFCEast)
+	a, b = east[0], east[1]
+	var nfc []string
+	for _, div := range []string{team.NFCEast, team.NFCNorth, team.NFCSouth} {
+		nfc = append(nfc, teamsIn(div)...)
+	}
+
+	sched = schedule.NewSchedule()
+	beat := func(week int, winner, loser string) {
+		sched.AddGame(week, game.Game{Winner: winner, Loser: loser, Home: winner, Away: loser, PtsWin: 20, PtsLose: 10})
+	}
+	beat(1, a, b)
+	beat(2, a, b)
+	beat(3, a, nfc[0])
+	beat(4, a, nfc[1])
+	beat(5, nfc[2], a)
+	beat(3, b, nfc[3])
+	beat(4, b, nfc[4])
+	beat(5, b, nfc[5])
+	for i, week := range []int{6, 7} {
+		sched.AddRemaining(week, game.Game{Home: a, Away: nfc[6+2*i]})
+		sched.AddRemaining(week, game.Game{Home: b, Away: nfc[7+2*i]})
+	}
+	return sched, a, b
+}
+
+// satisfied reports whether any of the conditions holds for the outcomes of the games
+func satisfied(conds []Condition, games []schedule.ScheduledGame, outcomes []standings.Outcome) bool {
+	for _, cond := range conds {
+		ok := true
+		for _, r := range cond {
+			for g, sg := range games {
+				if sg == r.Game && !slices.Contains(r.Outcomes, outcomes[g]) {
+					ok = false
+				}
+			}
+		}
+		if ok {
+			return true
+		}
+	}
+	return false
+}
+
+func TestWeek(t *testing.T) {
+	sched, a, b := divisionRace()
+
+	scenarios, err := Week(sched, 6)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var games []schedule.ScheduledGame
+	for _, sg := range sched.RemainingGames() {
+		if sg.Week == 6 {
+			games = append(games, sg)
+		}
+	}
+
+	// a clinches with a win, with a tie unless b wins, or with a loss if b loses too: b can at best finish level,
+	// and loses the tie on head-to-head. Nothing else is left for either team to clinch this week, as both have a
+	// berth already and b can't get past a
+	found := make(map[string]bool)
+	for _, s := range scenarios {
+		if s.Team == b || (s.Team == a && s.Goal == PlayoffBerth) {
+			t.Errorf("unexpected scenario %s", s)
+			continue
+		}
+		if s.Team != a {
+			continue
+		}
+		found[s.Goal] = true
+
+		for _, ao := range standings.Outcomes {
+			for _, bo := range standings.Outcomes {
+				want := ao == standings.HomeWin || (ao == standings.Tie && bo != standings.HomeWin) ||
+					(ao == standings.AwayWin && bo == standings.AwayWin)
+				if got := satisfied(s.Conditions, games, []standings.Outcome{ao, bo}); got != want {
+					t.Errorf("%s: with %s for a and %s for b expected %v, got %v", s, ao, bo, want, got)
+				}
+			}
+		}
+	}
+	if !found[Division] || !found[FirstRoundBye] {
+		t.Errorf("expected division and bye scenarios for %s, got %v", a, scenarios)
+	}
+}
+
+func TestWeekTooManyGames(t *testing.T) {
+	sched, a, _ := divisionRace()
+
+	defer func(n int) { MaxGames = n }(MaxGames)
+	MaxGames = 1
+	scenarios, err := Week(sched, 6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	found := false
+	for _, s := range scenarios {
+		if s.Team != a {
+			continue
+		}
+		found = true
+		if !s.TooManyGames || len(s.Conditions) > 0 {
+			t.Errorf("expected %s to have too many games to enumerate with two deciding games, got %s", a, s)
+		}
+		if want := a + " clinch " + s.Goal + " with: too many games to enumerate"; s.String() != want {
+			t.Errorf("expected %q, got %q", want, s.String())
+		}
+	}
+	if !found {
+		t.Errorf("expected %s to be reported with too many games, got %v", a, scenarios)
+	}
+
+	if _, err := Week(sched, 0); err == nil {
+		t.Errorf("expected an error for a week outside the schedule")
+	}
+}
+
+// TestWeek2023 checks the scenarios going into week 17 of 2023. San Francisco clinched the first seed that week, with
+// a 4-way tie that only strength of victory can order still possible in week 18, which used to count against it
+func TestWeek2023(t *testing.T) {
+	f, err := os.Open(filepath.Join("..", "golden", "testdata", "seasons", "2023", "games.csv"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	rows, err := scraper.ReadCSV(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	regular, _ := scraper.SplitPlayoffs(rows)
+	full := schedule.CreateSchedule(regular)
+
+	defer func(n int) { MaxGames = n }(MaxGames)
+	MaxGames = 4
+	sched := full.Through(16)
+	scenarios, err := Week(sched, 17)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var games []schedule.ScheduledGame
+	var outcomes []standings.Outcome
+	for _, sg := range sched.RemainingGames() {
+		if sg.Week != 17 {
+			continue
+		}
+		for _, g := range full.Weeks[16].Games {
+			if g.Home == sg.Game.Home && g.Away == sg.Game.Away {
+				games = append(games, sg)
+				switch g.Winner {
+				case g.Home:
+					outcomes = append(outcomes, standings.HomeWin)
+				case g.Away:
+					outcomes = append(outcomes, standings.AwayWin)
+				default:
+					outcomes = append(outcomes, standings.Tie)
+				}
+			}
+		}
+	}
+
+	var sf *Scenario
+	tooMany := false
+	for i, s := range scenarios {
+		tooMany = tooMany || s.TooManyGames
+		if s.Team == "San Francisco 49ers" && s.Goal == FirstRoundBye {
+			sf = &scenarios[i]
+		}
+	}
+	if sf == nil {
+		t.Fatalf("expected a first-round bye scenario for San Francisco, got %v", scenarios)
+	}
+	if want := "San Francisco 49ers clinch first-round bye with: Dallas Cowboys win AND Philadelphia Eagles loss AND " +
+		"San Francisco 49ers win"; sf.String() != want {
+		t.Errorf("expected %q, got %q", want, sf.String())
+	}
+	if !satisfied(sf.Conditions, games, outcomes) {
+		t.Errorf("expected the week's results to satisfy %s", sf)
+	}
+	if !tooMany {
+		t.Errorf("expected some goal with more than %d deciding games", MaxGames)
+	}
+}
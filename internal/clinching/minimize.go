@@ -0,0 +1,184 @@
+package clinching
+
+import (
+	"fmt"
+	"nfl-app/internal/schedule"
+	"nfl-app/internal/standings"
+	"nfl-app/internal/team"
+	"strings"
+)
+
+// cube is a set of combinations, holding for each game a bitmask of the outcomes it allows
+type cube []uint8
+
+// allOutcomes allows a home win, an away win or a tie
+const allOutcomes uint8 = 0b111
+
+// combinations calls fn with the index of every combination in the cube
+func (c cube) combinations(fn func(index int) bool) bool {
+	var walk func(game, index int) bool
+	walk = func(game, index int) bool {
+		if game == len(c) {
+			return fn(index)
+		}
+		for o := range standings.Outcomes {
+			if c[game]&(1<<o) == 0 {
+				continue
+			}
+			if !walk(game+1, index+o*pow3(game)) {
+				return false
+			}
+		}
+		return true
+	}
+	return walk(0, 0)
+}
+
+// minimize turns a truth table over combinations of game outcomes into a short list of conditions
+// Each true combination is grown into the largest cube that still only holds true combinations,
+// then cubes are picked greedily until every true combination is covered
+func minimize(games []schedule.ScheduledGame, table []bool) []Condition {
+	allTrue := func(c cube) bool {
+		return c.combinations(func(i int) bool { return table[i] })
+	}
+
+	covered := make([]bool, len(table))
+	cubes := make([]cube, 0)
+
+	for i, ok := range table {
+		if !ok || covered[i] {
+			continue
+		}
+
+		// Start from the single combination
+		c := make(cube, len(games))
+		for g := range games {
+			c[g] = 1 << int(outcomeAt(i, g))
+		}
+
+		// Widen one game at a time, first to any outcome, then to each extra outcome
+		for g := range games {
+			orig := c[g]
+			c[g] = allOutcomes
+			if allTrue(c) {
+				continue
+			}
+			c[g] = orig
+			for o := range standings.Outcomes {
+				if c[g]&(1<<o) != 0 {
+					continue
+				}
+				c[g] |= 1 << o
+				if !allTrue(c) {
+					c[g] &^= 1 << o
+				}
+			}
+		}
+
+		c.combinations(func(i int) bool {
+			covered[i] = true
+			return true
+		})
+		cubes = append(cubes, c)
+	}
+
+	cubes = dropRedundant(cubes, len(table))
+
+	out := make([]Condition, len(cubes))
+	for i, c := range cubes {
+		cond := make(Condition, 0)
+		for g, mask := range c {
+			if mask == allOutcomes {
+				continue
+			}
+			outcomes := make([]standings.Outcome, 0)
+			for o, outcome := range standings.Outcomes {
+				if mask&(1<<o) != 0 {
+					outcomes = append(outcomes, outcome)
+				}
+			}
+			cond = append(cond, Result{Game: games[g], Outcomes: outcomes})
+		}
+		out[i] = cond
+	}
+
+	return out
+}
+
+// dropRedundant removes cubes whose combinations are all covered by the other cubes
+func dropRedundant(cubes []cube, size int) []cube {
+	counts := make([]int, size)
+	for _, c := range cubes {
+		c.combinations(func(i int) bool {
+			counts[i]++
+			return true
+		})
+	}
+
+	out := make([]cube, 0, len(cubes))
+	for _, c := range cubes {
+		redundant := c.combinations(func(i int) bool { return counts[i] > 1 })
+		if !redundant {
+			out = append(out, c)
+			continue
+		}
+		c.combinations(func(i int) bool {
+			counts[i]--
+			return true
+		})
+	}
+	return out
+}
+
+// String describes the conditions in the style of the league's weekly clinching scenarios, for example
+// "Buffalo Bills clinch division with: Buffalo Bills win OR Buffalo Bills tie AND Miami Dolphins loss", or
+// "too many games to enumerate" in place of the conditions
+func (s Scenario) String() string {
+	if s.TooManyGames {
+		return fmt.Sprintf("%s clinch %s with: too many games to enumerate", s.Team, s.Goal)
+	}
+	conds := make([]string, len(s.Conditions))
+	for i, cond := range s.Conditions {
+		conds[i] = cond.describe(s.Team)
+	}
+	return fmt.Sprintf("%s clinch %s with: %s", s.Team, s.Goal, strings.Join(conds, " OR "))
+}
+
+func (c Condition) describe(subject string) string {
+	if len(c) == 0 {
+		return "any results"
+	}
+
+	parts := make([]string, len(c))
+	for i, r := range c {
+		parts[i] = r.describe(subject)
+	}
+	return strings.Join(parts, " AND ")
+}
+
+// describe phrases the result from the point of view of the subject team if it plays in the game,
+// otherwise from the team in the same conference as the subject, preferring the home team
+func (r Result) describe(subject string) string {
+	g := r.Game.Game
+	perspective := g.Home
+	switch {
+	case g.Home == subject || g.Away == subject:
+		perspective = subject
+	case !team.SameConference(g.Home, subject) && team.SameConference(g.Away, subject):
+		perspective = g.Away
+	}
+
+	words := make([]string, len(r.Outcomes))
+	for i, o := range r.Outcomes {
+		switch {
+		case o == standings.Tie:
+			words[i] = "tie"
+		case (o == standings.HomeWin) == (perspective == g.Home):
+			words[i] = "win"
+		default:
+			words[i] = "loss"
+		}
+	}
+
+	return fmt.Sprintf("%s %s", perspective, strings.Join(words, " or "))
+}
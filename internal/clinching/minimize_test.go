@@ -0,0 +1,82 @@
+package clinching
+
+import (
+	"math/rand"
+	"nfl-app/internal/game"
+	"nfl-app/internal/schedule"
+	"nfl-app/internal/standings"
+	"nfl-app/internal/team"
+	"testing"
+)
+
+func gamesOf(n int) []schedule.ScheduledGame {
+	games := make([]schedule.ScheduledGame, n)
+	for i := range games {
+		games[i] = schedule.ScheduledGame{Week: 1, Game: game.Game{
+			Home: team.NFLTeams[2*i].Name,
+			Away: team.NFLTeams[2*i+1].Name,
+		}}
+	}
+	return games
+}
+
+// outcomesAt returns the outcome of every game in the combination with the given index
+func outcomesAt(index, games int) []standings.Outcome {
+	out := make([]standings.Outcome, games)
+	for g := range out {
+		out[g] = outcomeAt(index, g)
+	}
+	return out
+}
+
+func TestMinimizeSingleGame(t *testing.T) {
+	games := gamesOf(2)
+	table := make([]bool, pow3(2))
+	for i := range table {
+		table[i] = outcomeAt(i, 0) == standings.HomeWin
+	}
+
+	conds := minimize(games, table)
+	if len(conds) != 1 || len(conds[0]) != 1 || conds[0][0].Game != games[0] ||
+		len(conds[0][0].Outcomes) != 1 || conds[0][0].Outcomes[0] != standings.HomeWin {
+		t.Fatalf("expected a home win in the first game, got %v", conds)
+	}
+
+	s := Scenario{Team: games[0].Game.Home, Goal: Division, Conditions: conds}
+	if want := games[0].Game.Home + " clinch division with: " + games[0].Game.Home + " win"; s.String() != want {
+		t.Errorf("expected %q, got %q", want, s.String())
+	}
+}
+
+func TestMinimizeAnyResults(t *testing.T) {
+	table := make([]bool, pow3(2))
+	for i := range table {
+		table[i] = true
+	}
+
+	conds := minimize(gamesOf(2), table)
+	if len(conds) != 1 || len(conds[0]) != 0 {
+		t.Fatalf("expected a single empty condition, got %v", conds)
+	}
+	if got := conds[0].describe(""); got != "any results" {
+		t.Errorf("expected any results, got %q", got)
+	}
+}
+
+func TestMinimizeCoversTable(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	games := gamesOf(3)
+	for range 50 {
+		table := make([]bool, pow3(len(games)))
+		for i := range table {
+			table[i] = r.Intn(3) == 0
+		}
+
+		conds := minimize(games, table)
+		for i, want := range table {
+			if got := satisfied(conds, games, outcomesAt(i, len(games))); got != want {
+				t.Fatalf("combination %v: expected %v, got %v from %v", outcomesAt(i, len(games)), want, got, conds)
+			}
+		}
+	}
+}
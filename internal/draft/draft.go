@@ -0,0 +1,142 @@
+package draft
+
+import (
+	"fmt"
+	"nfl-app/internal/entry"
+	"nfl-app/internal/entrysort"
+	"nfl-app/internal/game"
+	"nfl-app/internal/playoff"
+	"nfl-app/internal/schedule"
+	"nfl-app/internal/standings"
+	"nfl-app/internal/team"
+	"slices"
+	"sort"
+)
+
+// Order returns the entries in draft order, following the selection procedure in
+// https://operations.nfl.com/the-players/the-nfl-draft/the-rules-of-the-draft/
+//
+// Teams are grouped by how far they went in the playoffs, using exits as returned by playoff.Bracket.Exits.
+// Teams without an exit are treated as non-playoff teams and pick first. Within each group, the team
+// with the worse win percentage picks first, then the team with the easier strength of schedule.
+// Any remaining ties are broken by applying the divisional or conference tiebreakers in reverse
+func Order(entries []entry.Entry, teamSchedules map[string]schedule.Schedule, exits map[string]int) ([]entry.Entry, error) {
+	groups := make(map[int][]entry.Entry)
+	for _, e := range entries {
+		exit := exits[e.Team.Name]
+		groups[exit] = append(groups[exit], e)
+	}
+
+	rounds := make([]int, 0, len(groups))
+	for exit := range groups {
+		rounds = append(rounds, exit)
+	}
+	sort.Ints(rounds)
+
+	out := make([]entry.Entry, 0, len(entries))
+	for _, exit := range rounds {
+		ordered, err := orderGroup(groups[exit], teamSchedules)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, ordered...)
+	}
+
+	return out, nil
+}
+
+// orderGroup orders a group of teams that went out in the same round
+func orderGroup(entries []entry.Entry, teamSchedules map[string]schedule.Schedule) ([]entry.Entry, error) {
+	winPct := make(map[string]float64)
+	sos := make(map[string]float64)
+	for _, e := range entries {
+		winPct[e.Team.Name] = e.Stats.Record.WinPercentage()
+		sos[e.Team.Name] = e.Stats.StrengthOfSchedule
+	}
+
+	out := make([]entry.Entry, 0, len(entries))
+
+	// GroupEntries orders best first, so walk the groups backwards to put the worst team first
+	byPct := entry.GroupEntries(entries, winPct)
+	for i := len(byPct) - 1; i >= 0; i-- {
+		bySOS := entry.GroupEntries(byPct[i], sos)
+		for j := len(bySOS) - 1; j >= 0; j-- {
+			tied := bySOS[j]
+			if len(tied) == 1 {
+				out = append(out, tied...)
+				continue
+			}
+
+			// Still tied, so the team that would lose the tiebreaker picks first
+			sorted, err := entrysort.SortEntries(tied, teamSchedules)
+			if err != nil {
+				return nil, fmt.Errorf("breaking draft tie between %s: %w", entry.Teams(tied), err)
+			}
+			slices.Reverse(sorted)
+			out = append(out, sorted...)
+		}
+	}
+
+	return out, nil
+}
+
+// Season is the draft order worked out from a season's schedule and playoff results
+type Season struct {
+	Order []entry.Entry
+	Exits map[string]int
+
+	// Final is false while regular season or playoff games are left to play. Until then, teams
+	// still alive in the playoffs are placed as if they will win the Super Bowl
+	Final bool
+}
+
+// FromSeason ranks the schedule, plays the playoff games given so far through a bracket and returns the draft order
+func FromSeason(season int, sched schedule.Schedule, playoffGames []game.Game) (Season, error) {
+	st, err := standings.Rank(sched)
+	if err != nil {
+		return Season{}, err
+	}
+
+	bracket, err := playoff.NewBracket(season, st.Seeded())
+	if err != nil {
+		return Season{}, err
+	}
+	if err := bracket.AddResults(playoffGames); err != nil {
+		return Season{}, err
+	}
+
+	exits := bracket.Exits()
+	_, finished := bracket.Champion()
+	for _, conf := range team.Conferences {
+		for _, e := range bracket.Remaining(conf) {
+			if _, ok := exits[e.Team.Name]; !ok {
+				exits[e.Team.Name] = len(playoff.Rounds) + 1
+			}
+		}
+	}
+
+	order, err := Order(st.Entries, st.TeamSchedules, exits)
+	if err != nil {
+		return Season{}, err
+	}
+
+	return Season{
+		Order: order,
+		Exits: exits,
+		Final: finished && len(sched.RemainingGames()) == 0,
+	}, nil
+}
+
+// ExitName names the round a team went out in, given its exit from playoff.Bracket.Exits
+// Teams that missed the playoffs have no exit and get an empty name
+func ExitName(exit int, final bool) string {
+	switch {
+	case exit == 0:
+		return ""
+	case exit > len(playoff.Rounds) && final:
+		return "Champion"
+	case exit > len(playoff.Rounds):
+		return "Alive"
+	}
+	return playoff.Rounds[exit-1]
+}
@@ -0,0 +1,68 @@
+package draft
+
+import (
+	"nfl-app/internal/entry"
+	"nfl-app/internal/game"
+	"nfl-app/internal/schedule"
+	"nfl-app/internal/team"
+	"slices"
+	"testing"
+)
+
+func teamsIn(division string) []string {
+	var names []string
+	for _, t := range team.NFLTeams {
+		if t.Division == division {
+			names = append(names, t.Name)
+		}
+	}
+	return names
+}
+
+func TestOrder(t *testing.T) {
+	east, north := teamsIn(team.AFCEast), teamsIn(team.AFCNorth)
+	a, b, c, d := east[0], east[1], north[0], north[1]
+	f, e, g := east[2], north[2], north[3]
+	nfc := teamsIn(team.NFCEast)
+
+	sched := schedule.NewSchedule()
+	beat := func(week int, winner, loser string) {
+		sched.AddGame(week, game.Game{Winner: winner, Loser: loser, Home: winner, Away: loser, PtsWin: 20, PtsLose: 10})
+	}
+	// a, b, c and d all go 1-1, a beating b. f goes 0-2, and e and g, who made the playoffs, 0-1
+	beat(1, a, b)
+	beat(2, nfc[0], a)
+	beat(2, b, nfc[1])
+	beat(1, c, nfc[2])
+	beat(2, nfc[3], c)
+	beat(3, d, nfc[0])
+	beat(4, nfc[1], d)
+	beat(3, nfc[2], f)
+	beat(4, nfc[3], f)
+	beat(5, nfc[0], e)
+	beat(6, nfc[1], g)
+
+	byTeam := entry.ByTeam(schedule.CreateEntries(sched))
+	var entries []entry.Entry
+	for _, name := range []string{a, b, c, d, e, f, g} {
+		entries = append(entries, byTeam[name])
+	}
+	// a and b tie on strength of schedule as well, so the head-to-head loser b picks first
+	sos := map[string]float64{a: 0.5, b: 0.5, c: 0.4, d: 0.6}
+	for i := range entries {
+		entries[i].Stats.StrengthOfSchedule = sos[entries[i].Team.Name]
+	}
+	exits := map[string]int{e: 1, g: 2}
+
+	order, err := Order(entries, sched.SplitToTeams(), exits)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := make([]string, len(order))
+	for i, e := range order {
+		got[i] = e.Team.Name
+	}
+	if want := []string{f, c, b, a, d, e, g}; !slices.Equal(got, want) {
+		t.Errorf("expected the order\n%v\ngot\n%v", want, got)
+	}
+}
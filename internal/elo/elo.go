@@ -0,0 +1,138 @@
+// Package elo rates teams from their results with the Elo system, adjusted for home field and margin of victory
+package elo
+
+import (
+	"maps"
+	"math"
+	"nfl-app/internal/game"
+	"nfl-app/internal/schedule"
+	"nfl-app/internal/team"
+	"slices"
+)
+
+// Options control how ratings move
+type Options struct {
+	// K is the most a rating can move on an even game with the margin multiplier at 1
+	K float64
+
+	// HomeFieldAdvantage is added to the home team's rating when working out the expected result, in Elo points
+	HomeFieldAdvantage float64
+
+	// Initial is the rating of a team that has no rating yet, and the mean ratings regress to
+	Initial float64
+
+	// Regression is the share of the distance to Initial a rating loses between seasons
+	Regression float64
+}
+
+// DefaultOptions are the values commonly used for the NFL
+func DefaultOptions() Options {
+	return Options{
+		K:                  20,
+		HomeFieldAdvantage: 48,
+		Initial:            1500,
+		Regression:         1.0 / 3,
+	}
+}
+
+// Ratings holds a rating for every team, keyed by team name
+type Ratings map[string]float64
+
+// NewRatings gives every team the same rating
+func NewRatings(rating float64) Ratings {
+	r := make(Ratings)
+	for _, t := range team.NFLTeams {
+		r[t.Name] = rating
+	}
+	return r
+}
+
+// Regress returns the ratings for the start of a new season, each moved Regression of the way back to Initial
+func Regress(r Ratings, opts Options) Ratings {
+	out := make(Ratings)
+	for name, rating := range r {
+		out[name] = rating - (rating-opts.Initial)*opts.Regression
+	}
+	return out
+}
+
+// ExpectedHome is the home team's expected result against the away team, from 0 for a sure loss to 1 for a sure win
+func ExpectedHome(home, away float64, opts Options) float64 {
+	return 1 / (1 + math.Pow(10, -(home+opts.HomeFieldAdvantage-away)/400))
+}
+
+// Update moves the two teams' ratings for a played game
+// Blowouts move ratings further, but less so when the favorite wins, so strong teams do not run away on margin alone.
+// Ties move ratings as an even result would with no margin adjustment
+func (r Ratings) Update(g game.Game, opts Options) {
+	home, away := r.Rating(g.Home, opts), r.Rating(g.Away, opts)
+	expected := ExpectedHome(home, away, opts)
+
+	result, multiplier := 0.5, 1.0
+	if !g.IsTie() {
+		// The winner's rating edge, home field included
+		edge := home + opts.HomeFieldAdvantage - away
+		result = 1.0
+		if g.Winner == g.Away {
+			edge, result = -edge, 0
+		}
+		margin := float64(g.PtsWin - g.PtsLose)
+		multiplier = math.Log(max(margin, 1)+1) * 2.2 / (edge*0.001 + 2.2)
+	}
+
+	shift := opts.K * multiplier * (result - expected)
+	r[g.Home] = home + shift
+	r[g.Away] = away - shift
+}
+
+// Rating returns the team's rating, or Initial if it has none
+func (r Ratings) Rating(name string, opts Options) float64 {
+	if rating, ok := r[name]; ok {
+		return rating
+	}
+	return opts.Initial
+}
+
+// Season holds the ratings a season started with and the ratings after each of its weeks
+type Season struct {
+	Start Ratings
+
+	// Weeks holds the ratings after each week, index 0 being week 1, up to the last week with a result
+	Weeks []Ratings
+}
+
+// Rate runs through the schedule's results in week order, and by kickoff within a week where known
+// start holds the ratings going into the season, nil to start every team at Initial
+func Rate(sched schedule.Schedule, start Ratings, opts Options) Season {
+	if start == nil {
+		start = NewRatings(opts.Initial)
+	}
+	season := Season{Start: start}
+
+	current := maps.Clone(start)
+	for _, week := range sched.Weeks[:sched.LastPlayedWeek()] {
+		games := slices.Clone(week.Games)
+		slices.SortStableFunc(games, func(a, b game.Game) int { return a.Time.Compare(b.Time) })
+		for _, g := range games {
+			current.Update(g, opts)
+		}
+
+		season.Weeks = append(season.Weeks, maps.Clone(current))
+	}
+	return season
+}
+
+// Final returns the ratings after the last week with a result, or the starting ratings if there is none
+func (s Season) Final() Ratings {
+	return s.Week(len(s.Weeks))
+}
+
+// Week returns the ratings after the given week, week 0 being the start of the season
+// Weeks past the last one with a result give the latest ratings
+func (s Season) Week(week int) Ratings {
+	week = min(week, len(s.Weeks))
+	if week <= 0 {
+		return s.Start
+	}
+	return s.Weeks[week-1]
+}
@@ -0,0 +1,62 @@
+package elo
+
+import (
+	"math"
+	"nfl-app/internal/game"
+	"nfl-app/internal/schedule"
+	"testing"
+)
+
+func TestUpdate(t *testing.T) {
+	opts := DefaultOptions()
+
+	// An upset moves ratings further than the expected result, and a blowout further than a close game
+	shift := func(home, away float64, g game.Game) float64 {
+		r := Ratings{"Home": home, "Away": away}
+		r.Update(g, opts)
+		if math.Abs(r["Home"]+r["Away"]-home-away) > 1e-9 {
+			t.Fatalf("expected ratings to only move between the two teams, got %v", r)
+		}
+		return math.Abs(r["Home"] - home)
+	}
+	homeWin := func(pts int) game.Game {
+		return game.Game{Home: "Home", Away: "Away", Winner: "Home", Loser: "Away", PtsWin: pts, PtsLose: 10}
+	}
+	awayWin := game.Game{Home: "Home", Away: "Away", Winner: "Away", Loser: "Home", PtsWin: 17, PtsLose: 10}
+
+	if favored, upset := shift(1600, 1500, homeWin(17)), shift(1600, 1500, awayWin); upset <= favored {
+		t.Errorf("expected an upset to move ratings more, got %.2f against %.2f", upset, favored)
+	}
+	if close, blowout := shift(1500, 1500, homeWin(13)), shift(1500, 1500, homeWin(45)); blowout <= close {
+		t.Errorf("expected a blowout to move ratings more, got %.2f against %.2f", blowout, close)
+	}
+
+	// A tie between even teams moves the home team down, as it was expected to win
+	r := Ratings{"Home": 1500, "Away": 1500}
+	r.Update(game.Game{Home: "Home", Away: "Away", PtsWin: 20, PtsLose: 20}, opts)
+	if r["Home"] >= 1500 {
+		t.Errorf("expected the home team to lose rating on a tie, got %.2f", r["Home"])
+	}
+}
+
+func TestRate(t *testing.T) {
+	sched := schedule.NewSchedule()
+	sched.AddGame(1, game.Game{Home: "Buffalo Bills", Away: "Miami Dolphins", Winner: "Buffalo Bills", Loser: "Miami Dolphins", PtsWin: 31, PtsLose: 10})
+	sched.AddGame(3, game.Game{Home: "Miami Dolphins", Away: "Buffalo Bills", Winner: "Miami Dolphins", Loser: "Buffalo Bills", PtsWin: 24, PtsLose: 21})
+
+	season := Rate(sched, nil, DefaultOptions())
+	if len(season.Weeks) != 3 {
+		t.Fatalf("expected ratings for 3 weeks, got %d", len(season.Weeks))
+	}
+	if season.Week(1)["Buffalo Bills"] <= 1500 || season.Week(2)["Buffalo Bills"] != season.Week(1)["Buffalo Bills"] {
+		t.Errorf("expected the Bills to gain in week 1 and hold in week 2, got %v", season.Weeks)
+	}
+	if season.Week(0)["Buffalo Bills"] != 1500 || season.Final()["Buffalo Bills"] != season.Week(3)["Buffalo Bills"] {
+		t.Errorf("expected week 0 to be the start and Final the last week")
+	}
+
+	next := Regress(season.Final(), DefaultOptions())
+	if got, want := next["Buffalo Bills"]-1500, (season.Final()["Buffalo Bills"]-1500)*2/3; math.Abs(got-want) > 1e-9 {
+		t.Errorf("expected a third of the rating above the mean to be lost, got %.2f want %.2f", got, want)
+	}
+}
@@ -10,8 +10,8 @@ import (
 )
 
 type Entry struct {
-	Team  team.Team
-	Stats stats.Stats
+	Team  team.Team   `json:"team"`
+	Stats stats.Stats `json:"stats"`
 }
 
 func ConferenceEntries(entries []Entry, conference string) []Entry {
@@ -35,6 +35,9 @@ func (e *Entry) AddGame(game game.Game) {
 	// Points
 	e.UpdatePoints(game)
 
+	// Yards and turnovers
+	e.UpdateYards(game)
+
 	// Streak
 	e.UpdateStreak(game)
 
@@ -86,6 +89,26 @@ func (e *Entry) UpdateRecords(game game.Game) {
 		if team.SameConference(game.Winner, game.Loser) {
 			e.Stats.ConferenceRecord.AddLoss()
 		}
+	case game.IsTie():
+		// Overall
+		e.Stats.Record.AddTie()
+
+		// Home/Away
+		if game.Home == teamname {
+			e.Stats.HomeRecord.AddTie()
+		} else {
+			e.Stats.AwayRecord.AddTie()
+		}
+
+		// Division
+		if team.SameDivision(game.Home, game.Away) {
+			e.Stats.DivisionRecord.AddTie()
+		}
+
+		// Conference
+		if team.SameConference(game.Home, game.Away) {
+			e.Stats.ConferenceRecord.AddTie()
+		}
 	}
 }
 
@@ -111,6 +134,31 @@ func (e *Entry) UpdatePoints(game game.Game) {
 			e.Stats.ConferencePoints.AddFor(game.PtsLose)
 			e.Stats.ConferencePoints.AddAgainst(game.PtsWin)
 		}
+	case game.IsTie():
+		// Both teams scored the same, so for and against are equal
+		e.Stats.Points.AddFor(game.PtsWin)
+		e.Stats.Points.AddAgainst(game.PtsLose)
+
+		// Conference
+		if team.SameConference(game.Home, game.Away) {
+			e.Stats.ConferencePoints.AddFor(game.PtsWin)
+			e.Stats.ConferencePoints.AddAgainst(game.PtsLose)
+		}
+	}
+}
+
+func (e *Entry) UpdateYards(game game.Game) {
+	// The *Win fields belong to the winner, or to the home team in a tie
+	if game.Winner == e.Team.Name || (game.IsTie() && game.Home == e.Team.Name) {
+		e.Stats.Yards.Gained += game.YardsWin
+		e.Stats.Yards.Allowed += game.YardsLose
+		e.Stats.Turnovers.Committed += game.ToWin
+		e.Stats.Turnovers.Forced += game.ToLose
+	} else {
+		e.Stats.Yards.Gained += game.YardsLose
+		e.Stats.Yards.Allowed += game.YardsWin
+		e.Stats.Turnovers.Committed += game.ToLose
+		e.Stats.Turnovers.Forced += game.ToWin
 	}
 }
 
@@ -140,6 +188,15 @@ func NewEntry(teamname string) *Entry {
 	}
 }
 
+// ByTeam returns the entries keyed by team name
+func ByTeam(entries []Entry) map[string]Entry {
+	out := make(map[string]Entry, len(entries))
+	for _, entry := range entries {
+		out[entry.Team.Name] = entry
+	}
+	return out
+}
+
 func GroupByDivision(entries []Entry) map[string][]Entry {
 	out := make(map[string][]Entry)
 	for _, entry := range entries {
@@ -158,6 +215,7 @@ func GroupByConference(entries []Entry) map[string][]Entry {
 
 // GroupEntries will split the given slice into multiple slices, each containing a group of tied entries
 // For example {1, 1, 2, 3, 3, 3, 4, 5} --> {{1, 1}, {2}, {3, 3, 3}, {4}, {5}}
+// The given slice is not modified
 func GroupEntries(entries []Entry, sortBy map[string]float64) [][]Entry {
 	out := make([][]Entry, 0)
 
@@ -165,7 +223,8 @@ func GroupEntries(entries []Entry, sortBy map[string]float64) [][]Entry {
 		return out
 	}
 
-	// Sort the entries in descending order using sortBy
+	// Sort a copy of the entries in descending order using sortBy
+	entries = slices.Clone(entries)
 	sort.Slice(entries, func(i, j int) bool {
 		return sortBy[entries[i].Team.Name] > sortBy[entries[j].Team.Name]
 	})
@@ -227,3 +286,13 @@ func FilterEntries(entries []Entry, teams []team.Team) []Entry {
 	}
 	return filtered
 }
+
+// SortByColumn returns the entries ordered best first by the column, tied entries keeping their order
+// The given slice is not modified
+func SortByColumn(entries []Entry, column stats.Column) []Entry {
+	entries = slices.Clone(entries)
+	sort.SliceStable(entries, func(i, j int) bool {
+		return column.Better(&entries[i].Stats, &entries[j].Stats)
+	})
+	return entries
+}
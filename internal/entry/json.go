@@ -0,0 +1,36 @@
+package entry
+
+import (
+	"encoding/json"
+	"fmt"
+	"nfl-app/internal/stats"
+	"nfl-app/internal/team"
+)
+
+// entryJSON is the JSON shape of an Entry: {"team": {...}, "stats": {...}}
+type entryJSON struct {
+	Team  team.Team   `json:"team"`
+	Stats stats.Stats `json:"stats"`
+}
+
+// MarshalJSON encodes the entry's team and stats
+func (e Entry) MarshalJSON() ([]byte, error) {
+	return json.Marshal(entryJSON(e))
+}
+
+// UnmarshalJSON decodes an entry encoded by MarshalJSON. The team is looked up by name in the registry,
+// so its conference and division always match the rest of the app, and unknown teams are rejected
+func (e *Entry) UnmarshalJSON(data []byte) error {
+	var v entryJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	t := team.DisplayNameToTeam(v.Team.Name)
+	if t.Name == "" {
+		return fmt.Errorf("unknown team %q", v.Team.Name)
+	}
+
+	*e = Entry{Team: t, Stats: v.Stats}
+	return nil
+}
@@ -0,0 +1,60 @@
+package entrysort
+
+import (
+	"nfl-app/internal/entry"
+	"nfl-app/internal/team"
+	"slices"
+	"sync"
+	"testing"
+)
+
+// TestConcurrentSortsLeaveInputAlone sorts and seeds the same slice from many goroutines at once
+// Run it with -race to check the sorts share nothing they write to
+func TestConcurrentSortsLeaveInputAlone(t *testing.T) {
+	for _, tied := range []bool{false, true} {
+		entries, ts := benchSeason(tied)
+		afc := entry.ConferenceEntries(entries, team.AFC)
+		original := slices.Clone(afc)
+
+		want, err := SeedEntries(afc, ts)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		winPct := make(map[string]float64)
+		for _, e := range afc {
+			winPct[e.Team.Name] = e.Stats.Record.WinPercentage()
+		}
+
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				seeded, err := SeedEntries(afc, ts)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				// A tie that goes all the way to the coin toss can come out differently between runs
+				if !tied && !slices.Equal(seeded, want) {
+					t.Errorf("expected every run to seed the same way, got %s", entry.Teams(seeded))
+				}
+
+				if _, err := SortEntries(afc, ts); err != nil {
+					t.Error(err)
+				}
+				if _, _, err := SortEntriesTrace(afc, ts); err != nil {
+					t.Error(err)
+				}
+				entry.GroupEntries(afc, winPct)
+			}()
+		}
+		wg.Wait()
+
+		if !slices.Equal(afc, original) {
+			t.Errorf("expected the input to be left alone, got %s", entry.Teams(afc))
+		}
+	}
+}
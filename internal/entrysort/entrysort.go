@@ -2,42 +2,219 @@ package entrysort
 
 import (
 	"fmt"
+	"maps"
 	"nfl-app/internal/entry"
 	"nfl-app/internal/schedule"
+	"slices"
 	"sort"
 )
 
+// Debug enables printing every sort step to stdout, useful when following a tiebreaker by hand
+var Debug = false
+
+func debugf(format string, args ...any) {
+	if Debug {
+		fmt.Printf(format, args...)
+	}
+}
+
+// sortContext carries what a single top level sort needs through every recursive step
+type sortContext struct {
+	teamSchedules map[string]schedule.Schedule
+
+	// trace records each step when not nil
+	trace *Trace
+	depth int
+
+	// applied collects the name of every tiebreaker applied when not nil
+	applied map[string]bool
+
+	// watch is the team, if any, whose place the collected tiebreakers are limited to. Once it has been ranked first,
+	// or last of a group whose whole order is used, the tiebreakers applied to the teams left are not collected
+	watch string
+
+	// full is true while the whole order of the teams being sorted is used, rather than only the first or last team
+	full bool
+
+	// memo caches results by set of teams for the length of the sort, nil when memoize is off
+	memo *memo
+}
+
+// apply adds the tiebreakers to those collected, if any are
+func (ctx *sortContext) apply(applied map[string]bool) {
+	if ctx.applied != nil {
+		maps.Copy(ctx.applied, applied)
+	}
+}
+
+func newSortContext(teamSchedules map[string]schedule.Schedule, trace *Trace) *sortContext {
+	ctx := &sortContext{
+		teamSchedules: teamSchedules,
+		trace:         trace,
+		full:          true,
+	}
+	if memoize {
+		ctx.memo = newMemo()
+	}
+	return ctx
+}
+
 // SortEntries will sort the given entries by win percentage, default to tiebreakers specified
 // in https://www.nfl.com/standings/tie-breaking-procedures
+// The sorted entries are returned in a new slice and the given slice is left untouched, so SortEntries and the other
+// sorting and seeding functions are safe to call concurrently, as long as nothing writes to the team schedules meanwhile
 func SortEntries(entries []entry.Entry, teamSchedules map[string]schedule.Schedule) ([]entry.Entry, error) {
+	return sortEntries(newSortContext(teamSchedules, nil), slices.Clone(entries))
+}
+
+// SortEntriesTrace is SortEntries, also returning a trace of every tiebreaker applied along the way
+func SortEntriesTrace(entries []entry.Entry, teamSchedules map[string]schedule.Schedule) ([]entry.Entry, *Trace, error) {
+	trace := &Trace{}
+	sorted, err := sortEntries(newSortContext(teamSchedules, trace), slices.Clone(entries))
+	if err != nil {
+		return nil, nil, err
+	}
+	return sorted, trace, nil
+}
+
+// SortLevel sorts teams level on win percentage the way SortEntries orders them as part of the larger group,
+// returning the names of the tiebreakers applied. Which tiebreakers apply depends on the whole group: two teams
+// level in a division of four are broken with the three club division tiebreakers, for instance
+// Since each level is broken on its own, this gives the order SortEntries would without sorting the rest of the group
+func SortLevel(level, group []entry.Entry, teamSchedules map[string]schedule.Schedule) ([]entry.Entry, []string, error) {
+	return SortLevelFor(level, group, teamSchedules, "")
+}
+
+// SortLevelFor is SortLevel, returning only the tiebreakers applied until the named team's place among the level teams
+// is settled. Those applied afterwards only order teams it is already ranked ahead of or behind
+func SortLevelFor(level, group []entry.Entry, teamSchedules map[string]schedule.Schedule, teamname string) ([]entry.Entry, []string, error) {
+	if len(level) < 2 {
+		return slices.Clone(level), nil, nil
+	}
+
+	sorter, err := GetSorterFor(group)
+	if err != nil {
+		return nil, nil, err
+	}
+	if sorter.TiebreakMethod != subgroup {
+		return nil, nil, fmt.Errorf("%s does not split teams by win percentage", sorter.Name)
+	}
+
+	ctx := newSortContext(teamSchedules, nil)
+	ctx.applied = make(map[string]bool)
+	ctx.watch = teamname
+	sorted := sorter.Tiebreaker.sort(ctx, slices.Clone(level))
+	return sorted, slices.Sorted(maps.Keys(ctx.applied)), nil
+}
+
+func sortEntries(ctx *sortContext, entries []entry.Entry) ([]entry.Entry, error) {
 	if len(entries) < 2 {
 		return entries, nil
 	}
 
+	// A cached order would leave its steps out of the trace, so only use the cache when not tracing
+	useMemo := ctx.memo != nil && ctx.trace == nil
+	key := ctx.orderKey(entries)
+	if useMemo {
+		if sorted, applied, ok := ctx.memo.sorted(key); ok {
+			ctx.apply(applied)
+			return sorted, nil
+		}
+	}
+
 	sorter, err := GetSorterFor(entries)
 	if err != nil {
 		return nil, err
 	}
-	return sorter.Sort(entries, teamSchedules), nil
+
+	// Collect the tiebreakers applied to this set of teams on their own, so they can be cached along with its order
+	outer := ctx.applied
+	if useMemo && outer != nil {
+		ctx.applied = make(map[string]bool)
+	}
+	sorted := sorter.sort(ctx, entries)
+
+	if useMemo {
+		ctx.memo.storeSorted(key, sorted, ctx.applied)
+		if outer != nil {
+			maps.Copy(outer, ctx.applied)
+			ctx.applied = outer
+		}
+	}
+	return sorted, nil
 }
 
-// One general sort function for any sorter to use
+// watching reports whether the watched team is one of the entries
+func (ctx *sortContext) watching(entries []entry.Entry) bool {
+	return ctx.watch != "" && slices.ContainsFunc(entries, func(e entry.Entry) bool {
+		return e.Team.Name == ctx.watch
+	})
+}
+
+// orderKey is the key the order of the entries is cached under. Which tiebreakers are collected for a set holding the
+// watched team also depends on whether its whole order is used, so the two are cached apart
+func (ctx *sortContext) orderKey(entries []entry.Entry) string {
+	key := teamSetKey(entries)
+	if !ctx.full && ctx.watching(entries) {
+		key += "/partly"
+	}
+	return key
+}
+
+// partly runs sort on teams of which only the first or last is used, the rest being sorted again afterwards
+func (ctx *sortContext) partly(sort func()) {
+	full := ctx.full
+	ctx.full = false
+	sort()
+	ctx.full = full
+}
+
+// sortRest sorts the teams left once placed has been ranked first, or last. When placed is the watched team and the
+// rest are sure to stay on the other side of it, their order can't move it, so their tiebreakers are not collected
+func (ctx *sortContext) sortRest(placed entry.Entry, first bool, rest []entry.Entry) []entry.Entry {
+	if ctx.applied == nil || placed.Team.Name != ctx.watch || !(first || ctx.full) {
+		sorted, _ := sortEntries(ctx, rest) // TODO: Handle err
+		return sorted
+	}
+
+	outer := ctx.applied
+	ctx.applied = make(map[string]bool)
+	defer func() { ctx.applied = outer }()
+	sorted, _ := sortEntries(ctx, rest) // TODO: Handle err
+	return sorted
+}
+
+// Sort sorts the entries starting from this Sorter, falling through its tiebreakers as needed
 func (s *Sorter) Sort(entries []entry.Entry, teamSchedules map[string]schedule.Schedule) []entry.Entry {
+	return s.sort(newSortContext(teamSchedules, nil), slices.Clone(entries))
+}
+
+// One general sort function for any sorter to use
+func (s *Sorter) sort(ctx *sortContext, entries []entry.Entry) []entry.Entry {
 	// Validate
 	if len(entries) < 2 {
 		return entries
 	}
-	fmt.Printf("Sorting %s by %s\n", entry.Teams(entries), s.Name)
+	debugf("Sorting %s by %s\n", entry.Teams(entries), s.Name)
 
 	// Get sortBy
-	sortBy := s.SortByMap(entries, teamSchedules)
+	sortBy := ctx.memo.sortBy(s, entries, ctx.teamSchedules)
 	for sortByKey, sortByValue := range sortBy {
-		fmt.Printf("%s has %f\n", sortByKey, sortByValue)
+		debugf("%s has %f\n", sortByKey, sortByValue)
 	}
 
+	// Record the step, and nest any steps taken to break ties within it
+	step := ctx.trace.begin(ctx.depth, s.Name, entries, sortBy)
+	if ctx.applied != nil {
+		ctx.applied[s.Name] = true
+	}
+	ctx.depth++
+	defer func() { ctx.depth-- }()
+
 	// Check is sorted already?
 
-	// Sort the entries in descending order using sortBy
+	// Sort the entries in descending order using sortBy. Sort a copy, the caller's slice is left as it was
+	entries = slices.Clone(entries)
 	sort.Slice(entries, func(i, j int) bool {
 		return sortBy[entries[i].Team.Name] > sortBy[entries[j].Team.Name]
 	})
@@ -54,26 +231,31 @@ func (s *Sorter) Sort(entries []entry.Entry, teamSchedules map[string]schedule.S
 	//							     it may not actually be the worst team. The worst team may have been eliminated when finding the top division teams.
 	switch s.TiebreakMethod {
 	case subgroup:
-		return s.SubgroupSort(entries, teamSchedules, sortBy)
+		return s.subgroupSort(ctx, step, entries, sortBy)
 	case elimination:
-		return s.EliminationSort(entries, teamSchedules, sortBy)
+		return s.eliminationSort(ctx, step, entries, sortBy)
 	case doubleElimination:
-		return s.DoubleEliminationSort(entries, teamSchedules, sortBy)
+		return s.doubleEliminationSort(ctx, step, entries, sortBy)
 	case tripleElimination:
-		return TripleEliminationSort(entries, teamSchedules)
+		return tripleEliminationSort(ctx, step, entries)
 	}
 
 	panic(fmt.Sprintf("Unknown tiebreak method (%s) for sorter %s", s.TiebreakMethod, s.Name))
 }
 
-// SubgroupSort is used specifically at the top level for win percentage.
+// subgroupSort is used specifically at the top level for win percentage.
 // It will split the entries into subgroups based on the sortBy value and sort each subgroup using the tiebreaker
-func (s *Sorter) SubgroupSort(entries []entry.Entry, teamSchedules map[string]schedule.Schedule, sortBy map[string]float64) []entry.Entry {
+func (s *Sorter) subgroupSort(ctx *sortContext, step *Step, entries []entry.Entry, sortBy map[string]float64) []entry.Entry {
 	var sortedEntries []entry.Entry
 	var sortedSubgroup []entry.Entry
 
 	// Split entries into subgroups based on sortBy value
 	subgroups := entry.GroupEntries(entries, sortBy)
+	if len(subgroups) > 1 {
+		step.separate(fmt.Sprintf("split into %d groups", len(subgroups)))
+	} else {
+		step.tied()
+	}
 
 	for _, subgroup := range subgroups {
 		// Sort the subgroup based on the number of entries
@@ -81,85 +263,97 @@ func (s *Sorter) SubgroupSort(entries []entry.Entry, teamSchedules map[string]sc
 			sortedSubgroup = subgroup
 		} else {
 			// Sort the subgroup using the tiebreaker
-			sortedSubgroup = s.Tiebreaker.Sort(subgroup, teamSchedules)
+			sortedSubgroup = s.Tiebreaker.sort(ctx, subgroup)
 		}
 
 		// Append the sorted subgroup to the sortedEntries slice
-		sortedEntries = append(sortedEntries, sortedSubgroup...)
+		sortedEntries = slices.Concat(sortedEntries, sortedSubgroup)
 	}
 
 	return sortedEntries
 }
 
-// EliminationSort is the most commonly used tiebreaker method.
-// When evaluating a group of teams, EliminationSort will attempt to isolate either the sole best or sole worst team in the group.
+// eliminationSort is the most commonly used tiebreaker method.
+// When evaluating a group of teams, eliminationSort will attempt to isolate either the sole best or sole worst team in the group.
 // If one of those teams is identified, its spot will be locked in and all of the remaining teams will be sorted again, starting from the beginning.
 // If the sole worst/best is not found, the top group of tied entries will be sorted using the tiebreaker to find the sole best.
-func (s *Sorter) EliminationSort(entries []entry.Entry, teamSchedules map[string]schedule.Schedule, sortBy map[string]float64) []entry.Entry {
+func (s *Sorter) eliminationSort(ctx *sortContext, step *Step, entries []entry.Entry, sortBy map[string]float64) []entry.Entry {
 	subgroups := entry.GroupEntries(entries, sortBy)
 	topGroup := subgroups[0]
 	bottomGroup := subgroups[len(subgroups)-1]
 
 	if len(topGroup) == 1 {
 		// There is a best team. Award it first position and sort the rest using the root sort
-		restSorted, _ := SortEntries(entries[1:], teamSchedules) // TODO: Handle err
-		return append(topGroup, restSorted...)
+		step.separate(fmt.Sprintf("%s ranked first", topGroup[0].Team.Name))
+		restSorted := ctx.sortRest(topGroup[0], true, entries[1:])
+		return slices.Concat(topGroup, restSorted)
 	}
 
 	if len(bottomGroup) == 1 {
 		// There is a worst team. Award it last position and sort the rest using the root sort
-		restSorted, _ := SortEntries(entries[:len(entries)-1], teamSchedules) // TODO: Handle err
-		return append(restSorted, bottomGroup...)
+		step.separate(fmt.Sprintf("%s ranked last", bottomGroup[0].Team.Name))
+		restSorted := ctx.sortRest(bottomGroup[0], false, entries[:len(entries)-1])
+		return slices.Concat(restSorted, bottomGroup)
 	}
 
 	// We could not find a best/worst team using this Sorter
 	var topGroupSorted []entry.Entry
 	if len(topGroup) == len(entries) {
 		// If the top group is the same as the original entries, revert to the tiebreaker Sorter
-		topGroupSorted = s.Tiebreaker.Sort(topGroup, teamSchedules)
+		step.tied()
+		ctx.partly(func() { topGroupSorted = s.Tiebreaker.sort(ctx, topGroup) })
 	} else {
 		// If the top group is a subset of the original entries, it may require a different Sorter. Use the general SortEntries
-		topGroupSorted, _ = SortEntries(topGroup, teamSchedules) // TODO: Handle err
+		step.note(fmt.Sprintf("no single best or worst team, restarting with %s", entry.Teams(topGroup)))
+		ctx.partly(func() { topGroupSorted, _ = sortEntries(ctx, topGroup) }) // TODO: Handle err
 	}
 
 	// Separate the top entry from the others
 	topEntry := topGroupSorted[:1]
-	otherEntries := append(topGroupSorted[1:], entries[len(topGroup):]...)
+	otherEntries := slices.Concat(topGroupSorted[1:], entries[len(topGroup):])
 
 	// Sort the remaining teams using the root sort and combine with the top entry
-	otherEntriesSorted, _ := SortEntries(otherEntries, teamSchedules) // TODO: Handle err
-	return append(topEntry, otherEntriesSorted...)
+	otherEntriesSorted := ctx.sortRest(topEntry[0], true, otherEntries)
+	return slices.Concat(topEntry, otherEntriesSorted)
 }
 
-// DoubleEliminationSort is EliminationSort, however we make sure to eliminate any team that is not the top ranked team in their division
+// doubleEliminationSort is eliminationSort, however we make sure to eliminate any team that is not the top ranked team in their division
 // before determining the sole best. Because of this, we neglect determining the sole worst team.
-func (s *Sorter) DoubleEliminationSort(entries []entry.Entry, teamSchedules map[string]schedule.Schedule, sortBy map[string]float64) []entry.Entry {
+func (s *Sorter) doubleEliminationSort(ctx *sortContext, step *Step, entries []entry.Entry, sortBy map[string]float64) []entry.Entry {
 	// Before eliminating the sole best or worst, we need to eliminate
 	// any team that is not the top ranked team in their division
-	divTop, divBottom := FindDivisionTopTeams(entries, teamSchedules)
+	var divTop, divBottom []entry.Entry
+	ctx.partly(func() { divTop, divBottom = findDivisionTopTeams(ctx, entries) })
+	if len(divBottom) > 0 {
+		step.note(fmt.Sprintf("only the top team in each division is considered, setting aside %s", entry.Teams(divBottom)))
+	}
 
-	fmt.Printf("Top teams: %s\n", entry.Teams(divTop))
-	fmt.Printf("Eliminated teams: %s\n\n", entry.Teams(divBottom))
+	debugf("Top teams: %s\n", entry.Teams(divTop))
+	debugf("Eliminated teams: %s\n\n", entry.Teams(divBottom))
 
 	// Now basically just run EliminationSort on the top teams
+	// The code below indexes divTop by position, so put it in the order of the groups
 	subgroups := entry.GroupEntries(divTop, sortBy)
+	divTop = slices.Concat(subgroups...)
 	topGroup := subgroups[0]
 	bottomGroup := subgroups[len(subgroups)-1]
 
 	if len(topGroup) == 1 {
 		// There is a best team. Award it first position and sort the rest using the root sort
-		rest := append(divTop[1:], divBottom...)
-		restSorted, _ := SortEntries(rest, teamSchedules) // TODO: Handle err
-		return append(topGroup, restSorted...)
+		step.separate(fmt.Sprintf("%s ranked first", topGroup[0].Team.Name))
+		rest := slices.Concat(divTop[1:], divBottom)
+		restSorted := ctx.sortRest(topGroup[0], true, rest)
+		return slices.Concat(topGroup, restSorted)
 	}
 
 	// Only eliminate the worst team if we didn't eliminate any teams when finding the top division teams,
 	// otherwise we might eliminate a team that is not actually the worst
 	if len(bottomGroup) == 1 && len(divBottom) == 0 {
 		// There is a worst team. Award it last position and sort the rest using the root sort
+		step.separate(fmt.Sprintf("%s ranked last", bottomGroup[0].Team.Name))
 		rest := divTop[:len(divTop)-1]
-		restSorted, _ := SortEntries(rest, teamSchedules) // TODO: Handle err
-		return append(restSorted, bottomGroup...)
+		restSorted := ctx.sortRest(bottomGroup[0], false, rest)
+		return slices.Concat(restSorted, bottomGroup)
 	}
 
 	// TODO: We probably don't need to do the whole sort below, since we sort all but the top team
@@ -169,39 +363,42 @@ func (s *Sorter) DoubleEliminationSort(entries []entry.Entry, teamSchedules map[
 	var topGroupSorted []entry.Entry
 	if len(topGroup) == len(entries) {
 		// The top group is the same as the original entries, revert to the tiebreaker Sorter
-		topGroupSorted = s.Tiebreaker.Sort(topGroup, teamSchedules)
+		step.tied()
+		ctx.partly(func() { topGroupSorted = s.Tiebreaker.sort(ctx, topGroup) })
 	} else {
 		// The top group is a subset of the original entries, it may require a different Sorter
-		topGroupSorted, _ = SortEntries(topGroup, teamSchedules) // TODO: Handle err
+		step.note(fmt.Sprintf("no single best team, restarting with %s", entry.Teams(topGroup)))
+		ctx.partly(func() { topGroupSorted, _ = sortEntries(ctx, topGroup) }) // TODO: Handle err
 	}
 
 	// Separate the top entry from the others
 	topEntry := topGroupSorted[:1]
-	restOfDivTop := append(topGroupSorted[1:], divTop[len(topGroup):]...)
-	restOfEntries := append(restOfDivTop, divBottom...)
+	restOfEntries := slices.Concat(topGroupSorted[1:], divTop[len(topGroup):], divBottom)
 
 	// Sort the remaining teams using the root sort and combine with the top entry
-	restOfEntriesSorted, _ := SortEntries(restOfEntries, teamSchedules) // TODO: Handle err
-	return append(topEntry, restOfEntriesSorted...)
+	restOfEntriesSorted := ctx.sortRest(topEntry[0], true, restOfEntries)
+	return slices.Concat(topEntry, restOfEntriesSorted)
 }
 
 // TODO: This is just an inverse of the process used to determine draft order.
 // It's not entirely accurate because the draft order adds an initial tiebreaker to all ties:
 // 3. If ties exist in any grouping, such ties shall be broken by figuring the aggregate won-lost-tied percentage of each involved club's regular-season opponents and awarding preferential selection order to the club that faced the schedule of teams with the lowest aggregate won-lost-tied percentage.
-func TripleEliminationSort(entries []entry.Entry, teamSchedules map[string]schedule.Schedule) []entry.Entry {
+func tripleEliminationSort(ctx *sortContext, step *Step, entries []entry.Entry) []entry.Entry {
 	// (ii) Ties involving THREE-OR-MORE clubs from different conferences will be broken by applying
 	// (a) divisional tiebreakers to determine the lowest-ranked team in a division
 	divisionGroups := entry.GroupByDivision(entries)
 	var remaining []entry.Entry
 	worstInDivision := make([]entry.Entry, 0)
-	for _, teams := range divisionGroups {
+	for _, div := range slices.Sorted(maps.Keys(divisionGroups)) {
+		teams := divisionGroups[div]
 		if len(teams) == 1 {
 			worstInDivision = append(worstInDivision, teams[0])
 			continue
 		}
 
 		// Sort the teams and take the worst one
-		sortedTeams, _ := SortEntries(teams, teamSchedules) // TODO: Handle err
+		var sortedTeams []entry.Entry
+		ctx.partly(func() { sortedTeams, _ = sortEntries(ctx, teams) }) // TODO: Handle err
 
 		// Split the slice into the worst team and the remaining teams
 		remainingTeams, worstTeam := entry.SplitAround(sortedTeams, len(sortedTeams)-1)
@@ -213,14 +410,16 @@ func TripleEliminationSort(entries []entry.Entry, teamSchedules map[string]sched
 	// (b) conference tiebreakers to determine the lowest-ranked team within a conference, and
 	conferenceGroups := entry.GroupByConference(worstInDivision)
 	worstInConference := make([]entry.Entry, 0)
-	for _, teams := range conferenceGroups {
+	for _, conf := range slices.Sorted(maps.Keys(conferenceGroups)) {
+		teams := conferenceGroups[conf]
 		if len(teams) == 1 {
 			worstInConference = append(worstInConference, teams[0])
 			continue
 		}
 
 		// Sort the teams and take the worst one
-		sortedTeams, _ := SortEntries(teams, teamSchedules) // TODO: Handle err
+		var sortedTeams []entry.Entry
+		ctx.partly(func() { sortedTeams, _ = sortEntries(ctx, teams) }) // TODO: Handle err
 
 		// Split the slice into the worst team and the remaining teams
 		remainingTeams, worstTeam := entry.SplitAround(sortedTeams, len(sortedTeams)-1)
@@ -231,26 +430,34 @@ func TripleEliminationSort(entries []entry.Entry, teamSchedules map[string]sched
 
 	// (c) interconference tiebreakers to determine the lowest ranked team in the league
 	// Sort the teams and take the worst one
-	sortedTeams, _ := SortEntries(worstInConference, teamSchedules) // TODO: Handle err
+	var sortedTeams []entry.Entry
+	ctx.partly(func() { sortedTeams, _ = sortEntries(ctx, worstInConference) }) // TODO: Handle err
 
 	// Split the slice into the worst team and the remaining teams
 	remainingTeams, worstTeam := entry.SplitAround(sortedTeams, len(sortedTeams)-1)
 
 	worstInLeague := worstTeam
 	remaining = append(remaining, remainingTeams...)
+	step.separate(fmt.Sprintf("%s ranked last", worstInLeague[0].Team.Name))
 
-	remainingSorted, _ := SortEntries(remaining, teamSchedules) // TODO: Handle error
-	return append(remainingSorted, worstInLeague...)
+	remainingSorted := ctx.sortRest(worstInLeague[0], false, remaining)
+	return slices.Concat(remainingSorted, worstInLeague)
 }
 
 // FindDivisionTopTeams will return a subset of the original group of entries containing all the highest ranked teams
 // in each division, as well as a subset of the original group of entries containing all the other teams in each division
 func FindDivisionTopTeams(entries []entry.Entry, teamSchedules map[string]schedule.Schedule) ([]entry.Entry, []entry.Entry) {
+	return findDivisionTopTeams(newSortContext(teamSchedules, nil), entries)
+}
+
+func findDivisionTopTeams(ctx *sortContext, entries []entry.Entry) ([]entry.Entry, []entry.Entry) {
 	top := make([]entry.Entry, 0)
 	other := make([]entry.Entry, 0)
 
+	// Go through the divisions in a fixed order, as the coin toss depends on the order of the teams
 	divisionGroups := entry.GroupByDivision(entries)
-	for _, group := range divisionGroups {
+	for _, div := range slices.Sorted(maps.Keys(divisionGroups)) {
+		group := divisionGroups[div]
 		// If there is only one team in the division, they are the top team
 		if len(group) == 1 {
 			top = append(top, group[0])
@@ -258,7 +465,7 @@ func FindDivisionTopTeams(entries []entry.Entry, teamSchedules map[string]schedu
 		}
 
 		// Otherwise, sort the teams and take the top one
-		sortedGroup, _ := SortEntries(group, teamSchedules) // TODO: Handle err
+		sortedGroup, _ := sortEntries(ctx, group) // TODO: Handle err
 
 		topTeam, otherTeams := entry.SplitAround(sortedGroup, 1)
 		top = append(top, topTeam...)
@@ -281,9 +488,24 @@ func FindDivisionTopTeams(entries []entry.Entry, teamSchedules map[string]schedu
 
 // SeedEntries will sort the entries as they would be seeded in the playoffs
 // This means that the top team in each division is seeded 1-4, and the rest are seeded 5+
+// Seeds are set on the returned entries, never on the given ones
 func SeedEntries(entries []entry.Entry, ts map[string]schedule.Schedule) ([]entry.Entry, error) {
+	return seedEntries(newSortContext(ts, nil), slices.Clone(entries))
+}
+
+// SeedEntriesTrace is SeedEntries, also returning a trace of every tiebreaker applied along the way
+func SeedEntriesTrace(entries []entry.Entry, ts map[string]schedule.Schedule) ([]entry.Entry, *Trace, error) {
+	trace := &Trace{}
+	seeded, err := seedEntries(newSortContext(ts, trace), slices.Clone(entries))
+	if err != nil {
+		return nil, nil, err
+	}
+	return seeded, trace, nil
+}
+
+func seedEntries(ctx *sortContext, entries []entry.Entry) ([]entry.Entry, error) {
 	// Sort entries by win percentage
-	sortedEntries, err := SortEntries(entries, ts)
+	sortedEntries, err := sortEntries(ctx, entries)
 	if err != nil {
 		return nil, err
 	}
@@ -293,7 +515,10 @@ func SeedEntries(entries []entry.Entry, ts map[string]schedule.Schedule) ([]entr
 	wildSeed := 5
 
 	divisionsSeen := make(map[string]bool)
-	for _, entry := range sortedEntries {
+	for i := range sortedEntries {
+		// Index into the slice so the seed is stored on the returned entries rather than a copy
+		entry := &sortedEntries[i]
+
 		// If we haven't seen the division yet, we know this is a division winner,
 		// so it assign it to the highest available seed in 1-4. Otherwise assign it to 5+
 		if _, ok := divisionsSeen[entry.Team.Division]; !ok {
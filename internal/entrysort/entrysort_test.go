@@ -2,162 +2,101 @@ package entrysort
 
 import (
 	"nfl-app/internal/entry"
+	"nfl-app/internal/schedule"
 	"nfl-app/internal/team"
+	"os"
+	"strings"
 	"testing"
 )
 
-func orderMatched(entries []entry.Entry, teams []team.Team) bool {
-	if len(entries) != len(teams) {
-		return false
+func TestScenarios(t *testing.T) {
+	data, err := os.ReadFile("testdata/scenarios.txt")
+	if err != nil {
+		t.Fatal(err)
 	}
-
-	for i := range entries {
-		if entries[i].Team.Name != teams[i].Name {
-			return false
-		}
+	scenarios, err := ParseScenarios(string(data))
+	if err != nil {
+		t.Fatal(err)
 	}
 
-	return true
+	for _, s := range scenarios {
+		t.Run(s.Name, func(t *testing.T) {
+			if err := s.Check(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
 }
 
-// TODO: If possible, should we test both ways in case a group of entries is already sorted by default?
-// Or maybe just ensure it isn't when we give it as input?
-func TestSortEntries(t *testing.T) {
+func TestParseScenariosErrors(t *testing.T) {
 	tests := []struct {
-		name    string
-		args    Scenario
-		want    []team.Team // Just use the team instead of the entire entry
-		wantErr bool
+		name string
+		text string
+		want string
 	}{
-		// {
-		// 	name:    "Division 2 Clubs Head to Head",
-		// 	args:    HeadToHead(team.NewEnglandPatriots, team.NewYorkJets),
-		// 	want:    []team.Team{team.NewEnglandPatriots, team.NewYorkJets},
-		// 	wantErr: false,
-		// },
-		// {
-		// 	name:    "Division 2 Clubs Division Record",
-		// 	args:    Division2ClubsDivisionRecord(),
-		// 	want:    []team.Team{team.NewEnglandPatriots, team.NewYorkJets},
-		// 	wantErr: false,
-		// },
-		// {
-		// 	name:    "Division 2 Clubs Common Games",
-		// 	args:    Division2ClubsCommonGames(),
-		// 	want:    []team.Team{team.NewEnglandPatriots, team.NewYorkJets},
-		// 	wantErr: false,
-		// },
-		// {
-		// 	name:    "Division 2 Clubs Conference Record",
-		// 	args:    ConferenceRecord(team.NewEnglandPatriots, team.NewYorkJets),
-		// 	want:    []team.Team{team.NewEnglandPatriots, team.NewYorkJets},
-		// 	wantErr: false,
-		// },
-		// {
-		// 	name:    "Division 2 Clubs Strength of Victory",
-		// 	args:    StrengthOfVictory(team.NewEnglandPatriots, team.NewYorkJets),
-		// 	want:    []team.Team{team.NewEnglandPatriots, team.NewYorkJets},
-		// 	wantErr: false,
-		// },
-		// {
-		// 	name:    "Division 2 Clubs Strength of Schedule",
-		// 	args:    Division2ClubsStrengthOfSchedule(),
-		// 	want:    []team.Team{team.NewEnglandPatriots, team.NewYorkJets},
-		// 	wantErr: false,
-		// },
-		// {
-		// 	name:    "Division 2 Clubs best combined ranking among conference teams in points scored and points allowed in all games",
-		// 	args:    Division2ClubsConferenceRank(),
-		// 	want:    []team.Team{team.NewEnglandPatriots, team.NewYorkJets},
-		// 	wantErr: false,
-		// },
-		// {
-		// 	name:    "Division 2 Clubs best combined ranking among all teams in points scored and points allowed in all games",
-		// 	args:    Division2ClubsLeagueRank(),
-		// 	want:    []team.Team{team.NewEnglandPatriots, team.NewYorkJets},
-		// 	wantErr: false,
-		// },
-		// {
-		// 	name:    "Division 2 Clubs Net points common games",
-		// 	args:    Division2ClubsNetPointsCommonGames(),
-		// 	want:    []team.Team{team.NewEnglandPatriots, team.NewYorkJets},
-		// 	wantErr: false,
-		// },
-		// Cannot test coin toss as it is random
-		// {
-		// 	name:    "Division 2 Clubs Net points",
-		// 	args:    Division2ClubsNetPoints(),
-		// 	want:    []team.Team{team.NewEnglandPatriots, team.NewYorkJets},
-		// 	wantErr: false,
-		// },
-		// {
-		// 	name:    "Conference 2 Clubs Head to Head",
-		// 	args:    HeadToHead(team.NewEnglandPatriots, team.LasVegasRaiders),
-		// 	want:    []team.Team{team.NewEnglandPatriots, team.LasVegasRaiders},
-		// 	wantErr: false,
-		// },
-		// {
-		// 	name:    "Conference 2 Clubs Conference Record",
-		// 	args:    ConferenceRecord(team.NewEnglandPatriots, team.LasVegasRaiders),
-		// 	want:    []team.Team{team.NewEnglandPatriots, team.LasVegasRaiders},
-		// 	wantErr: false,
-		// },
-		// {
-		// 	name:    "Conference 2 Clubs Common Games",
-		// 	args:    Conference2ClubsCommonGames(),
-		// 	want:    []team.Team{team.NewEnglandPatriots, team.LasVegasRaiders},
-		// 	wantErr: false,
-		// },
-		// {
-		// 	name:    "Conference 2 Clubs Strength of Victory",
-		// 	args:    StrengthOfVictory(team.NewEnglandPatriots, team.LasVegasRaiders),
-		// 	want:    []team.Team{team.NewEnglandPatriots, team.LasVegasRaiders},
-		// 	wantErr: false,
-		// },
-		// {
-		// 	name:    "Conference 2 Clubs Strength of Schedule",
-		// 	args:    Conference2ClubsStrengthOfSchedule(),
-		// 	want:    []team.Team{team.NewEnglandPatriots, team.LasVegasRaiders},
-		// 	wantErr: false,
-		// },
-		// {
-		// 	name:    "Conference 2 Clubs best combined ranking among conference teams in points scored and points allowed in all games",
-		// 	args:    Conference2ClubsConferenceRank(),
-		// 	want:    []team.Team{team.NewEnglandPatriots, team.LasVegasRaiders},
-		// 	wantErr: false,
-		// },
-		// {
-		// 	name:    "Conference 2 Clubs best combined ranking among all teams in points scored and points allowed in all games",
-		// 	args:    Conference2ClubsLeagueRank(),
-		// 	want:    []team.Team{team.NewEnglandPatriots, team.LasVegasRaiders},
-		// 	wantErr: false,
-		// },
-		// {
-		// 	name:    "Conference 2 Clubs net points conference",
-		// 	args:    Conference2ClubsNetPointsConference(),
-		// 	want:    []team.Team{team.NewEnglandPatriots, team.LasVegasRaiders},
-		// 	wantErr: false,
-		// },
-		// {
-		// 	name:    "Conference 2 Clubs net points",
-		// 	args:    Conference2ClubsNetPoints(),
-		// 	want:    []team.Team{team.NewEnglandPatriots, team.LasVegasRaiders},
-		// 	wantErr: false,
-		// },
+		{"no scenario line", "wk1: NE @ BUF 24-17", "scenario: line first"},
+		{"unknown team", "scenario: x\nwk1: NE @ XYZ 24-17", "unknown team"},
+		{"two games in a week", "scenario: x\nwk1: NE @ BUF 24-17\nwk1: NE @ MIA 24-17", "already plays"},
+		{"week out of range", "scenario: x\nwk19: NE @ BUF 24-17", "outside of the season"},
+		{"one tied team", "scenario: x\nwk1: NE @ BUF 24-17\nexpect: NE", "at least two"},
+		{"team without games", "scenario: x\nwk1: NE @ BUF 24-17\nexpect: NE, NYJ", "played a game"},
+		{"bad line", "scenario: x\nNE beat BUF", "cannot read"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := SortEntries(tt.args.entries, tt.args.schedules)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("SortEntries() error = %v, wantErr %v", err, tt.wantErr)
-				return
+			_, err := ParseScenarios(tt.text)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("got %v, want an error containing %q", err, tt.want)
 			}
-			if len(got) != len(tt.want) {
-				t.Errorf("Length mismatch")
+		})
+	}
+}
+
+func TestTiebreakerBasis(t *testing.T) {
+	roots := []*Sorter{
+		WithinDivisionTwoClubsSorter(), WithinDivisionThreeClubsSorter(),
+		WithinConferenceTwoClubsSorter(), WithinConferenceThreeClubsSorter(),
+		WithinLeagueTwoClubsSorter(), WithinLeagueThreeClubsSorter(),
+	}
+	for _, root := range roots {
+		for s := root; s != nil; s = s.Tiebreaker {
+			name := strings.ToLower(s.Name)
+			want := OwnRecords
+			switch {
+			case strings.Contains(name, "strength"):
+				want = OpponentRecords
+			case strings.Contains(name, "points") || strings.Contains(name, "rank") || s.Name == coinToss:
+				want = Scores
 			}
-			if !orderMatched(got, tt.want) {
-				t.Errorf("Order mismatch\nGot: %s\nWant: %s\n", entry.Names(got), team.Names(tt.want))
+			if got := TiebreakerBasis(s.Name); got != want {
+				t.Errorf("expected %q to have basis %d, got %d", s.Name, want, got)
 			}
-		})
+		}
+	}
+}
+
+func TestCoinTossIsRepeatable(t *testing.T) {
+	// Nothing played, so the whole conference goes to the coin toss, which follows the order of the teams given
+	var entries []entry.Entry
+	for _, tm := range team.NFLTeams {
+		if tm.Conference == team.AFC {
+			entries = append(entries, *entry.NewEntry(tm.Name))
+		}
+	}
+	sched := schedule.NewSchedule()
+	ts := sched.SplitToTeams()
+
+	first, err := SeedEntries(entries, ts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for range 10 {
+		again, err := SeedEntries(entries, ts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if entry.Names(again) != entry.Names(first) {
+			t.Fatalf("expected the same seeding every time\n%s\ngot\n%s", entry.Names(first), entry.Names(again))
+		}
 	}
 }
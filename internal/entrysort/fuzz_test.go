@@ -0,0 +1,244 @@
+package entrysort
+
+import (
+	"math/rand"
+	"nfl-app/internal/entry"
+	"nfl-app/internal/game"
+	"nfl-app/internal/schedule"
+	"nfl-app/internal/team"
+	"slices"
+	"testing"
+)
+
+// fuzzSeason plays the given number of weeks of random pairings. Scores are kept low so that ties in the standings,
+// and the odd tied game, are common enough to reach deep into the tiebreakers
+func fuzzSeason(r *rand.Rand, weeks int) ([]entry.Entry, map[string]schedule.Schedule) {
+	sched := schedule.NewSchedule()
+	for week := 1; week <= weeks; week++ {
+		order := r.Perm(len(team.NFLTeams))
+		for i := 0; i < len(order); i += 2 {
+			home, away := team.NFLTeams[order[i]].Name, team.NFLTeams[order[i+1]].Name
+			g := game.Game{Home: home, Away: away, PtsWin: r.Intn(4) * 7}
+			if r.Intn(10) == 0 {
+				g.PtsLose = g.PtsWin
+			} else {
+				g.PtsWin += 3
+				g.PtsLose = r.Intn(g.PtsWin)
+				g.Winner, g.Loser = home, away
+				if r.Intn(2) == 0 {
+					g.Winner, g.Loser = away, home
+				}
+			}
+			sched.AddGame(week, g)
+		}
+	}
+	return schedule.CreateEntries(sched), sched.SplitToTeams()
+}
+
+// fuzzGroup picks the entries to sort: a division, a conference, the whole league or a random set of teams
+func fuzzGroup(r *rand.Rand, entries []entry.Entry, kind uint8) []entry.Entry {
+	switch kind % 4 {
+	case 0:
+		return entry.GroupByDivision(entries)[team.Divisions[r.Intn(len(team.Divisions))]]
+	case 1:
+		return entry.ConferenceEntries(entries, team.Conferences[r.Intn(len(team.Conferences))])
+	case 2:
+		return slices.Clone(entries)
+	default:
+		teams, err := team.NewTeamGen(r.Uint64()).Generate(2 + r.Intn(len(team.NFLTeams)-1))
+		if err != nil {
+			panic(err)
+		}
+		return entry.FilterEntries(entries, teams)
+	}
+}
+
+// reachedCoinToss reports whether any tie had to be broken by the coin toss, the one step that depends on input order
+func reachedCoinToss(trace *Trace) bool {
+	for _, step := range trace.Steps {
+		if step.Tiebreaker == coinToss {
+			return true
+		}
+	}
+	return false
+}
+
+func shuffled(r *rand.Rand, entries []entry.Entry) []entry.Entry {
+	out := slices.Clone(entries)
+	r.Shuffle(len(out), func(i, j int) { out[i], out[j] = out[j], out[i] })
+	return out
+}
+
+func teamNames(entries []entry.Entry) []string {
+	out := make([]string, len(entries))
+	for i, e := range entries {
+		out[i] = e.Team.Name
+	}
+	return out
+}
+
+func checkPermutation(t *testing.T, got, input []entry.Entry) {
+	t.Helper()
+	want := teamNames(input)
+	have := teamNames(got)
+	slices.Sort(want)
+	slices.Sort(have)
+	if !slices.Equal(have, want) {
+		t.Fatalf("expected a permutation of the input\n got %v\nwant %v", have, want)
+	}
+}
+
+// checkWinPercentage fails when a team ranks below a team with a strictly worse win percentage
+func checkWinPercentage(t *testing.T, entries []entry.Entry) {
+	t.Helper()
+	for i := 1; i < len(entries); i++ {
+		prev, cur := entries[i-1], entries[i]
+		if cur.Stats.Record.WinPercentage() > prev.Stats.Record.WinPercentage() {
+			t.Fatalf("%s (%.3f) ranked below %s (%.3f)", cur.Team.Name, cur.Stats.Record.WinPercentage(),
+				prev.Team.Name, prev.Stats.Record.WinPercentage())
+		}
+	}
+}
+
+// FuzzSortEntries sorts groups of teams from random seasons and checks the result is a permutation of the input, in
+// win percentage order, and the same whatever order the input is in unless a coin toss was needed
+// go test only runs the seeds below, run go test -fuzz FuzzSortEntries ./internal/entrysort to search for more
+func FuzzSortEntries(f *testing.F) {
+	for seed := int64(0); seed < 8; seed++ {
+		f.Add(seed, uint8(seed*3), uint8(seed))
+	}
+
+	f.Fuzz(func(t *testing.T, seed int64, weeks uint8, kind uint8) {
+		r := rand.New(rand.NewSource(seed))
+		entries, ts := fuzzSeason(r, 1+int(weeks)%17)
+		group := fuzzGroup(r, entries, kind)
+
+		sorted, trace, err := SortEntriesTrace(group, ts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		checkPermutation(t, sorted, group)
+		checkWinPercentage(t, sorted)
+
+		again, err := SortEntries(shuffled(r, group), ts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reachedCoinToss(trace) && !slices.Equal(teamNames(again), teamNames(sorted)) {
+			t.Fatalf("expected the same order from shuffled input\n got %v\nwant %v\n%s", entry.Teams(again), entry.Teams(sorted), trace)
+		}
+	})
+}
+
+// FuzzSeedEntries seeds a conference from random seasons and checks the division winners take the first seeds, each
+// part is in win percentage order and the seeds do not depend on input order unless a coin toss was needed
+func FuzzSeedEntries(f *testing.F) {
+	for seed := int64(0); seed < 8; seed++ {
+		f.Add(seed, uint8(seed*3))
+	}
+
+	f.Fuzz(func(t *testing.T, seed int64, weeks uint8) {
+		r := rand.New(rand.NewSource(seed))
+		entries, ts := fuzzSeason(r, 1+int(weeks)%17)
+		conf := entry.ConferenceEntries(entries, team.Conferences[r.Intn(len(team.Conferences))])
+
+		seeded, trace, err := SeedEntriesTrace(conf, ts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		checkPermutation(t, seeded, conf)
+
+		// Seeds are set on the entries, which stay in sorted order
+		seeded = slices.SortedFunc(slices.Values(seeded), func(a, b entry.Entry) int { return a.Stats.Seed - b.Stats.Seed })
+		divisions := len(entry.GroupByDivision(conf))
+		seeds := make(map[string]int)
+		for i, e := range seeded {
+			seeds[e.Team.Name] = e.Stats.Seed
+			if e.Stats.Seed != i+1 {
+				t.Fatalf("expected seeds 1 to %d, %s has seed %d", len(seeded), e.Team.Name, e.Stats.Seed)
+			}
+		}
+		// Division winners come first and lead their divisions, and each part is in win percentage order
+		checkWinPercentage(t, seeded[:divisions])
+		checkWinPercentage(t, seeded[divisions:])
+		winners := make(map[string]float64)
+		for _, e := range seeded[:divisions] {
+			winners[e.Team.Division] = e.Stats.Record.WinPercentage()
+		}
+		if len(winners) != divisions {
+			t.Fatalf("expected one winner per division, got %v", entry.Teams(seeded[:divisions]))
+		}
+		for _, e := range seeded[divisions:] {
+			if e.Stats.Record.WinPercentage() > winners[e.Team.Division] {
+				t.Fatalf("%s has a better record than the winner of the %s", e.Team.Name, e.Team.Division)
+			}
+		}
+
+		again, err := SeedEntries(shuffled(r, conf), ts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, e := range again {
+			if want := seeds[e.Team.Name]; !reachedCoinToss(trace) && e.Stats.Seed != want {
+				t.Fatalf("expected %s to be seeded %d from shuffled input, got %d\n%s", e.Team.Name, want, e.Stats.Seed, trace)
+			}
+		}
+	})
+}
+
+// FuzzSortLevel checks that sorting one level of win percentage on its own gives the order the whole group is
+// sorted in, unless a coin toss was needed
+func FuzzSortLevel(f *testing.F) {
+	for seed := int64(0); seed < 8; seed++ {
+		f.Add(seed, uint8(seed*3), uint8(seed))
+	}
+
+	f.Fuzz(func(t *testing.T, seed int64, weeks uint8, kind uint8) {
+		r := rand.New(rand.NewSource(seed))
+		entries, ts := fuzzSeason(r, 1+int(weeks)%17)
+		group := fuzzGroup(r, entries, kind)
+		if sorter, err := GetSorterFor(group); err != nil || sorter.TiebreakMethod != subgroup {
+			// Across conferences the worst team is found first, rather than splitting by win percentage
+			return
+		}
+
+		sorted, err := SortEntries(group, ts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		winPct := make(map[string]float64)
+		for _, e := range group {
+			winPct[e.Team.Name] = e.Stats.Record.WinPercentage()
+		}
+		for _, level := range entry.GroupEntries(sorted, winPct) {
+			want := teamNames(level)
+			level = shuffled(r, level)
+			got, applied, err := SortLevel(level, group, ts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if slices.Contains(applied, coinToss) {
+				continue
+			}
+			if !slices.Equal(teamNames(got), want) {
+				t.Fatalf("expected the level in the order of the whole group\n got %v\nwant %v, applying %v", teamNames(got), want, applied)
+			}
+
+			// Watching a team gives the same order, and only leaves tiebreakers out
+			for _, e := range level {
+				watched, some, err := SortLevelFor(level, group, ts, e.Team.Name)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !slices.Equal(teamNames(watched), teamNames(got)) {
+					t.Fatalf("expected the same order watching %s\n got %v\nwant %v", e.Team.Name, teamNames(watched), teamNames(got))
+				}
+				for _, name := range some {
+					if !slices.Contains(applied, name) {
+						t.Fatalf("watching %s, %s was applied but not without watching", e.Team.Name, name)
+					}
+				}
+			}
+		}
+	})
+}
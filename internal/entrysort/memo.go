@@ -0,0 +1,78 @@
+package entrysort
+
+import (
+	"nfl-app/internal/entry"
+	"nfl-app/internal/schedule"
+	"slices"
+	"strings"
+)
+
+// memoize turns on caching within a sort, see memo. It is only turned off to benchmark without the cache
+var memoize = true
+
+// memo caches the work done within a single top level sort. Breaking a large tie sorts the same subsets of teams
+// over and over, as every elimination restarts from the top with one team fewer, and each restart recomputes
+// head-to-head records, common opponents and opponent maps for groups already seen
+// Within a sort every team's stats and schedule are fixed, so a result for a set of teams holds each time the set
+// comes up again, whatever order the teams are in. The one exception is the coin toss, which is never cached
+// (a sorted order that got as far as the coin toss is cached, keeping the first toss made for that set)
+type memo struct {
+	// sortBys is keyed by tiebreaker name and team set
+	sortBys map[string]map[string]float64
+
+	// orders is keyed by team set
+	orders map[string]memoOrder
+}
+
+// memoOrder is the order found for a set of teams, and the tiebreakers applied to find it when they were collected
+type memoOrder struct {
+	sorted  []entry.Entry
+	applied map[string]bool
+}
+
+func newMemo() *memo {
+	return &memo{
+		sortBys: make(map[string]map[string]float64),
+		orders:  make(map[string]memoOrder),
+	}
+}
+
+// teamSetKey identifies a set of teams regardless of their order
+func teamSetKey(entries []entry.Entry) string {
+	names := make([]string, len(entries))
+	for i, e := range entries {
+		names[i] = e.Team.Name
+	}
+	slices.Sort(names)
+	return strings.Join(names, "|")
+}
+
+// sortBy returns the sorter's values for the entries, computing them only the first time the set of teams is seen
+// It is safe to call on a nil memo, which computes the values every time
+func (m *memo) sortBy(s *Sorter, entries []entry.Entry, ts map[string]schedule.Schedule) map[string]float64 {
+	if m == nil || s.Name == coinToss {
+		return s.SortByMap(entries, ts)
+	}
+
+	key := s.Name + "/" + teamSetKey(entries)
+	if sortBy, ok := m.sortBys[key]; ok {
+		return sortBy
+	}
+	sortBy := s.SortByMap(entries, ts)
+	m.sortBys[key] = sortBy
+	return sortBy
+}
+
+// sorted returns a copy of the order found earlier for the same set of teams, by its key, and the tiebreakers applied
+// to find it. Copies are handed out because callers sort and append to what they are given
+func (m *memo) sorted(key string) ([]entry.Entry, map[string]bool, bool) {
+	order, ok := m.orders[key]
+	if !ok {
+		return nil, nil, false
+	}
+	return slices.Clone(order.sorted), order.applied, true
+}
+
+func (m *memo) storeSorted(key string, sorted []entry.Entry, applied map[string]bool) {
+	m.orders[key] = memoOrder{sorted: slices.Clone(sorted), applied: applied}
+}
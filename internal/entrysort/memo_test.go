@@ -0,0 +1,96 @@
+package entrysort
+
+import (
+	"math/rand"
+	"nfl-app/internal/entry"
+	"nfl-app/internal/game"
+	"nfl-app/internal/schedule"
+	"nfl-app/internal/team"
+	"slices"
+	"testing"
+)
+
+// benchSeason plays a 17 week round robin style season. With tied set, every game ends 20-20, so every team in a
+// group stays level through almost every tiebreaker, which is the worst case for a sort
+func benchSeason(tied bool) ([]entry.Entry, map[string]schedule.Schedule) {
+	r := rand.New(rand.NewSource(1))
+	sched := schedule.NewSchedule()
+
+	// Circle method: keep the first team fixed and rotate the rest each week
+	teams := make([]string, len(team.NFLTeams))
+	for i, t := range team.NFLTeams {
+		teams[i] = t.Name
+	}
+	for week := 1; week <= 17; week++ {
+		for i := 0; i < len(teams)/2; i++ {
+			home, away := teams[i], teams[len(teams)-1-i]
+			g := game.Game{Home: home, Away: away, PtsWin: 20, PtsLose: 20}
+			if !tied {
+				g.Winner, g.Loser = home, away
+				if r.Intn(2) == 0 {
+					g.Winner, g.Loser = away, home
+				}
+				g.PtsWin, g.PtsLose = 20+r.Intn(20), r.Intn(20)
+			}
+			sched.AddGame(week, g)
+		}
+		teams = append(teams[:1], append(teams[len(teams)-1:], teams[1:len(teams)-1]...)...)
+	}
+
+	return schedule.CreateEntries(sched), sched.SplitToTeams()
+}
+
+func benchmarkSeed(b *testing.B, tied bool) {
+	entries, ts := benchSeason(tied)
+	afc := entry.ConferenceEntries(entries, team.AFC)
+
+	for _, memo := range []bool{false, true} {
+		name := "uncached"
+		if memo {
+			name = "memoized"
+		}
+		b.Run(name, func(b *testing.B) {
+			defer func(m bool) { memoize = m }(memoize)
+			memoize = memo
+			for i := 0; i < b.N; i++ {
+				if _, err := SeedEntries(afc, ts); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkSeedTied seeds a conference of 16 teams level on everything, as happens with large ties in simulations
+func BenchmarkSeedTied(b *testing.B) {
+	benchmarkSeed(b, true)
+}
+
+func BenchmarkSeed(b *testing.B) {
+	benchmarkSeed(b, false)
+}
+
+func TestMemoizedMatchesUncached(t *testing.T) {
+	entries, ts := benchSeason(false)
+
+	seed := func(memo bool) []string {
+		defer func(m bool) { memoize = m }(memoize)
+		memoize = memo
+
+		names := make([]string, 0)
+		for _, conf := range team.Conferences {
+			seeded, err := SeedEntries(entry.ConferenceEntries(entries, conf), ts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, e := range seeded {
+				names = append(names, e.Team.Name)
+			}
+		}
+		return names
+	}
+
+	if uncached, memoized := seed(false), seed(true); !slices.Equal(uncached, memoized) {
+		t.Errorf("memoized seeds differ:\n%v\n%v", memoized, uncached)
+	}
+}
@@ -1,883 +1,205 @@
 package entrysort
 
 import (
+	"bufio"
 	"fmt"
 	"nfl-app/internal/entry"
 	"nfl-app/internal/game"
 	"nfl-app/internal/schedule"
 	"nfl-app/internal/team"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
 )
 
-// This file contains a series of "scenarios" (a combination of entries and team schedules)
+// This file contains a small text format for writing tiebreaker "scenarios" (a set of games, the teams tied
+// after them and the order the tiebreakers should put them in), such as the examples on
+// https://www.nfl.com/standings/tie-breaking-procedures
 //
-// Each scenario is meant to test a specific tiebreaker scenario
-// from https://www.nfl.com/standings/tie-breaking-procedures
+// A scenario starts with its name and lists its games one per line, the away team first with its score first.
+// Teams are anything team.Lookup accepts. Equal scores are a tie. The expect line gives the tied teams in the
+// order they should be sorted, optionally followed by the tiebreaker that should separate them
+//
+//	scenario: Division 2 clubs, head to head
+//	wk1: NE @ NYJ 24-17
+//	wk2: NE @ HOU 10-20
+//	wk2: NYJ @ CIN 20-10
+//	expect: NE, NYJ by Head to Head
+//
+// Blank lines and lines starting with # are ignored
 
 type Scenario struct {
-	entries   []entry.Entry
-	schedules map[string]schedule.Schedule
-}
-
-// HeadToHead returns a general scenario in which t1 breaks
-// the tie with t2 by head to head record
-func HeadToHead(t1, t2 team.Team) Scenario {
-	// Get two other teams for them to play
-	others := team.GetRandomTeams(2, []team.Team{t1, t2}, nil, "")
-
-	sched := schedule.NewSchedule()
-
-	// Week 1: t1 beats t2
-	t1Att2 := game.Game{
-		Winner: t1.Name,
-		Loser:  t2.Name,
-		Home:   t2.Name,
-		Away:   t1.Name,
-	}
-	sched.AddGame(1, t1Att2)
-
-	// Week 2: t1 loses to an other, t2 beats an other. Records evened
-	t1AtOther1 := game.Game{
-		Winner: others[0].Name,
-		Loser:  t1.Name,
-		Home:   others[0].Name,
-		Away:   t1.Name,
-	}
-	t2AtOther2 := game.Game{
-		Winner: t2.Name,
-		Loser:  others[1].Name,
-		Home:   others[1].Name,
-		Away:   t2.Name,
-	}
-	sched.AddGame(2, t1AtOther1)
-	sched.AddGame(2, t2AtOther2)
-
-	entries := schedule.CreateEntries(sched)
-	// Filter so only the tied teams we care about are sorted
-	filtered := entry.FilterEntries(entries, []team.Team{t1, t2})
-	return Scenario{
-		entries:   filtered,
-		schedules: sched.SplitToTeams(),
-	}
-}
-
-func Division2ClubsDivisionRecord() Scenario {
-	sched := schedule.NewSchedule()
-
-	// Pats 1-1, 1-0 in div
-	// Jets 1-1, 0-1 in div
-
-	// Week 1: Pats get their division win, Jets get their loss
-	patsAtBills := game.Game{
-		Winner: team.NewEnglandPatriots.Name,
-		Loser:  team.BuffaloBills.Name,
-		Home:   team.BuffaloBills.Name,
-		Away:   team.NewEnglandPatriots.Name,
-	}
-	finsAtJets := game.Game{
-		Winner: team.MiamiDolphins.Name,
-		Loser:  team.NewYorkJets.Name,
-		Home:   team.NewYorkJets.Name,
-		Away:   team.MiamiDolphins.Name,
-	}
-	sched.AddGame(1, patsAtBills)
-	sched.AddGame(1, finsAtJets)
-
-	// Week 2: Pats lose and Jets win to even win pct. Both games out of division
-	bengalsAtPats := game.Game{
-		Winner: team.CincinnatiBengals.Name,
-		Loser:  team.NewEnglandPatriots.Name,
-		Home:   team.NewEnglandPatriots.Name,
-		Away:   team.CincinnatiBengals.Name,
-	}
-	jetsAtSteelers := game.Game{
-		Winner: team.NewYorkJets.Name,
-		Loser:  team.PittsburghSteelers.Name,
-		Home:   team.PittsburghSteelers.Name,
-		Away:   team.NewYorkJets.Name,
-	}
-	sched.AddGame(2, bengalsAtPats)
-	sched.AddGame(2, jetsAtSteelers)
-
-	entries := schedule.CreateEntries(sched)
-	// Filter so only the tied teams we care about are sorted
-	filtered := entry.FilterEntries(entries, []team.Team{team.NewEnglandPatriots, team.NewYorkJets})
-	return Scenario{
-		entries:   filtered,
-		schedules: sched.SplitToTeams(),
-	}
-}
-
-func Division2ClubsCommonGames() Scenario {
-	sched := schedule.NewSchedule()
-
-	// Pats 1-1, 0-0 div, 1-0 v Texans
-	// Jets 1-1, 0-0 div, 0-1 v Texans
-
-	// Week 1: Pats get their Texans win. Jets also win
-	patsAtTexans := game.Game{
-		Winner: team.NewEnglandPatriots.Name,
-		Loser:  team.HoustonTexans.Name,
-		Home:   team.HoustonTexans.Name,
-		Away:   team.NewEnglandPatriots.Name,
-	}
-	jetsAtSteelers := game.Game{
-		Winner: team.NewYorkJets.Name,
-		Loser:  team.PittsburghSteelers.Name,
-		Home:   team.PittsburghSteelers.Name,
-		Away:   team.NewYorkJets.Name,
-	}
-	sched.AddGame(1, patsAtTexans)
-	sched.AddGame(1, jetsAtSteelers)
-
-	// Week 2: Jets get their Texans loss. Pats also lose
-	bengalsAtPats := game.Game{
-		Winner: team.CincinnatiBengals.Name,
-		Loser:  team.NewEnglandPatriots.Name,
-		Home:   team.NewEnglandPatriots.Name,
-		Away:   team.CincinnatiBengals.Name,
-	}
-	texansAtJets := game.Game{
-		Winner: team.HoustonTexans.Name,
-		Loser:  team.NewYorkJets.Name,
-		Home:   team.NewYorkJets.Name,
-		Away:   team.HoustonTexans.Name,
-	}
-	sched.AddGame(2, bengalsAtPats)
-	sched.AddGame(2, texansAtJets)
-
-	entries := schedule.CreateEntries(sched)
-	// Filter so only the tied teams we care about are sorted
-	filtered := entry.FilterEntries(entries, []team.Team{team.NewEnglandPatriots, team.NewYorkJets})
-	return Scenario{
-		entries:   filtered,
-		schedules: sched.SplitToTeams(),
-	}
-}
-
-func StrengthOfVictory(t1, t2 team.Team) Scenario {
-	sched := schedule.NewSchedule()
-	// Get two other teams for them to play
-	//
-	// If the two teams given are from the same conference, select two teams from the
-	// other conference as opponents so we don't interferece with previous tiebreakers
-	//
-	// If the two teams given are from different conferences, select any other two teams as the
-	// only league tiebreakers before strength of victory are head to head and common games
-	var noConf string
-	if t1.Conference == t2.Conference {
-		noConf = t1.Conference
-	}
-	others := team.GetRandomTeams(4, []team.Team{t1, t2}, nil, noConf)
-
-	t1Opps := []string{others[0].Name, others[1].Name} // Win all games not against t1 --> good SOV
-	t2Opps := []string{others[2].Name, others[3].Name} // Lose all games (including t2) --> bad SOV
-
-	// t1 | 2-0 | Opponents 2-2
-	// t2 | 2-0 | Opponents 0-4
-
-	// Week 1: t1 and t2 both win
-	// t1's future opponent wins, t2's future opponent loses
-	t1AtOpp := game.Game{
-		Winner: t1.Name,
-		Loser:  t1Opps[0],
-		Home:   t1Opps[0],
-		Away:   t1.Name,
-	}
-	t2AtOpp := game.Game{
-		Winner: t2.Name,
-		Loser:  t2Opps[0],
-		Home:   t2Opps[0],
-		Away:   t2.Name,
-	}
-	t1OppAtt2Opp := game.Game{
-		Winner: t1Opps[1],
-		Loser:  t2Opps[1],
-		Home:   t2Opps[1],
-		Away:   t1Opps[1],
-	}
-	sched.AddGame(1, t1AtOpp)
-	sched.AddGame(1, t2AtOpp)
-	sched.AddGame(1, t1OppAtt2Opp)
-
-	// Week 1: t1 and t2 both win
-	// t1's previous opponent wins, t2's previous opponent loses
-	oppAtt1 := game.Game{
-		Winner: t1.Name,
-		Loser:  t1Opps[1],
-		Home:   t1.Name,
-		Away:   t1Opps[1],
-	}
-	oppAtt2 := game.Game{
-		Winner: t2.Name,
-		Loser:  t2Opps[1],
-		Home:   t2.Name,
-		Away:   t2Opps[1],
-	}
-	t2OppAtt1Opp := game.Game{
-		Winner: t1Opps[0],
-		Loser:  t2Opps[0],
-		Home:   t1Opps[0],
-		Away:   t2Opps[0],
-	}
-	sched.AddGame(1, oppAtt1)
-	sched.AddGame(1, oppAtt2)
-	sched.AddGame(1, t2OppAtt1Opp)
-
-	entries := schedule.CreateEntries(sched)
-	// Filter so only the tied teams we care about are sorted
-	filtered := entry.FilterEntries(entries, []team.Team{t1, t2})
-	return Scenario{
-		entries:   filtered,
-		schedules: sched.SplitToTeams(),
-	}
-}
-
-func Division2ClubsStrengthOfSchedule() Scenario {
-	sched := schedule.NewSchedule()
-
-	// Pats 1-1, Opponents 2-2
-	// Jets 1-1, Opponents 1-3
-
-	// Week 1: Pats and Jets both win.
-	// Seahawks (Pats future opponent) win, Rams (Jets future opponent) lose
-	patsAtCards := game.Game{
-		Winner: team.NewEnglandPatriots.Name,
-		Loser:  team.ArizonaCardinals.Name,
-		Home:   team.ArizonaCardinals.Name,
-		Away:   team.NewEnglandPatriots.Name,
-	}
-	jetsAtGiants := game.Game{
-		Winner: team.NewYorkJets.Name,
-		Loser:  team.NewYorkGiants.Name,
-		Home:   team.NewYorkGiants.Name,
-		Away:   team.NewYorkJets.Name,
-	}
-	seahawksAtRams := game.Game{
-		Winner: team.SeattleSeahawks.Name,
-		Loser:  team.LosAngelesRams.Name,
-		Home:   team.LosAngelesRams.Name,
-		Away:   team.SeattleSeahawks.Name,
-	}
-	sched.AddGame(1, patsAtCards)
-	sched.AddGame(1, jetsAtGiants)
-	sched.AddGame(1, seahawksAtRams)
-
-	// Week 2: Pats and Jets both lose. Pats to a better team
-	// Cardinals (Pats previous opponent) and Giants (Jets previous opponent) both lose to keep SOV the same
-	seahawksAtPats := game.Game{
-		Winner: team.SeattleSeahawks.Name,
-		Loser:  team.NewEnglandPatriots.Name,
-		Home:   team.NewEnglandPatriots.Name,
-		Away:   team.SeattleSeahawks.Name,
-	}
-	ramsAtJets := game.Game{
-		Winner: team.LosAngelesRams.Name,
-		Loser:  team.NewYorkJets.Name,
-		Home:   team.NewYorkJets.Name,
-		Away:   team.LosAngelesRams.Name,
-	}
-	cardinalsAtPanthers := game.Game{
-		Winner: team.CarolinaPanthers.Name,
-		Loser:  team.ArizonaCardinals.Name,
-		Home:   team.CarolinaPanthers.Name,
-		Away:   team.ArizonaCardinals.Name,
-	}
-	giantsAtBears := game.Game{
-		Winner: team.ChicagoBears.Name,
-		Loser:  team.NewYorkGiants.Name,
-		Home:   team.ChicagoBears.Name,
-		Away:   team.NewYorkGiants.Name,
-	}
-	sched.AddGame(2, seahawksAtPats)
-	sched.AddGame(2, ramsAtJets)
-	sched.AddGame(2, cardinalsAtPanthers)
-	sched.AddGame(2, giantsAtBears)
-
-	entries := schedule.CreateEntries(sched)
-	// Filter so only the tied teams we care about are sorted
-	filtered := entry.FilterEntries(entries, []team.Team{team.NewEnglandPatriots, team.NewYorkJets})
-	return Scenario{
-		entries:   filtered,
-		schedules: sched.SplitToTeams(),
-	}
-}
-
-func Division2ClubsConferenceRank() Scenario {
-	sched := schedule.NewSchedule()
-
-	// Pats 1-0, 30 points for, 20 points against
-	// Jets 1-0, 29 points for, 21 points against
-
-	// Week 1: Pats and Jets both win. Pats score more and allow less
-	patsAtCards := game.Game{
-		Winner:  team.NewEnglandPatriots.Name,
-		Loser:   team.ArizonaCardinals.Name,
-		Home:    team.ArizonaCardinals.Name,
-		Away:    team.NewEnglandPatriots.Name,
-		PtsWin:  30,
-		PtsLose: 20,
-	}
-	jetsAtGiants := game.Game{
-		Winner:  team.NewYorkJets.Name,
-		Loser:   team.NewYorkGiants.Name,
-		Home:    team.NewYorkGiants.Name,
-		Away:    team.NewYorkJets.Name,
-		PtsWin:  29,
-		PtsLose: 21,
-	}
-	sched.AddGame(1, patsAtCards)
-	sched.AddGame(1, jetsAtGiants)
-
-	entries := schedule.CreateEntries(sched)
-	// Filter so only the tied teams we care about are sorted
-	filtered := entry.FilterEntries(entries, []team.Team{team.NewEnglandPatriots, team.NewYorkJets})
-	return Scenario{
-		entries:   filtered,
-		schedules: sched.SplitToTeams(),
-	}
-}
-
-func Division2ClubsLeagueRank() Scenario {
-	sched := schedule.NewSchedule()
-
-	// Points For:
-	// 1. Patriots  (AFC) - 40
-	// 2. Cardinals (NFC) - 30
-	// 3. Giants    (NFC) - 25
-	// 4. Jets      (AFC) - 20
-	// 5. Bears     (NFC) - 16
-
-	// Points Against:
-	// 1. Bears     (NFC) - 15
-	// 1. Jets      (AFC) - 17
-	// 2. Giants    (NFC) - 20
-	// 3. Patriots  (AFC) - 30
-	// 4. Cardinals (NFC) - 55
-
-	// Week 1:
-	patsAtCards := game.Game{
-		Winner:  team.NewEnglandPatriots.Name,
-		Loser:   team.ArizonaCardinals.Name,
-		Home:    team.ArizonaCardinals.Name,
-		Away:    team.NewEnglandPatriots.Name,
-		PtsWin:  40,
-		PtsLose: 30,
-	}
-	jetsAtGiants := game.Game{
-		Winner:  team.NewYorkJets.Name,
-		Loser:   team.NewYorkGiants.Name,
-		Home:    team.NewYorkGiants.Name,
-		Away:    team.NewYorkJets.Name,
-		PtsWin:  20,
-		PtsLose: 17,
-	}
-	sched.AddGame(1, patsAtCards)
-	sched.AddGame(1, jetsAtGiants)
-
-	// Week 2: Giants overtake Jets in PF, lose to keep SOV/SOS same
-	giantsAtBears := game.Game{
-		Winner:  team.ChicagoBears.Name,
-		Loser:   team.NewYorkGiants.Name,
-		Home:    team.NewYorkGiants.Name,
-		Away:    team.ChicagoBears.Name,
-		PtsWin:  16,
-		PtsLose: 15,
-	}
-	sched.AddGame(2, giantsAtBears)
-
-	entries := schedule.CreateEntries(sched)
-	// Filter so only the tied teams we care about are sorted
-	filtered := entry.FilterEntries(entries, []team.Team{team.NewEnglandPatriots, team.NewYorkJets})
-	return Scenario{
-		entries:   filtered,
-		schedules: sched.SplitToTeams(),
-	}
-}
-
-func Division2ClubsNetPointsCommonGames() Scenario {
-	sched := schedule.NewSchedule()
-
-	// Pats 1-1, 0-0 div, 1-0 v Texans (+10 net)
-	// Jets 1-1, 0-0 div, 1-0 v Texans (+5 net)
-
-	// Week 1: Pats get their Texans win. Jets lose
-	patsAtTexans := game.Game{
-		Winner:  team.NewEnglandPatriots.Name,
-		Loser:   team.HoustonTexans.Name,
-		Home:    team.HoustonTexans.Name,
-		Away:    team.NewEnglandPatriots.Name,
-		PtsWin:  30,
-		PtsLose: 20,
-	}
-	jetsAtSteelers := game.Game{
-		Winner: team.PittsburghSteelers.Name,
-		Loser:  team.NewYorkJets.Name,
-		Home:   team.PittsburghSteelers.Name,
-		Away:   team.NewYorkJets.Name,
-	}
-	sched.AddGame(1, patsAtTexans)
-	sched.AddGame(1, jetsAtSteelers)
-
-	// Week 2: Jets get their Texans win. Pats lose
-	bengalsAtPats := game.Game{
-		Winner: team.CincinnatiBengals.Name,
-		Loser:  team.NewEnglandPatriots.Name,
-		Home:   team.NewEnglandPatriots.Name,
-		Away:   team.CincinnatiBengals.Name,
-	}
-	texansAtJets := game.Game{
-		Winner:  team.NewYorkJets.Name,
-		Loser:   team.HoustonTexans.Name,
-		Home:    team.NewYorkJets.Name,
-		Away:    team.HoustonTexans.Name,
-		PtsWin:  20,
-		PtsLose: 15,
-	}
-	sched.AddGame(2, bengalsAtPats)
-	sched.AddGame(2, texansAtJets)
-
-	entries := schedule.CreateEntries(sched)
-	// Filter so only the tied teams we care about are sorted
-	filtered := entry.FilterEntries(entries, []team.Team{team.NewEnglandPatriots, team.NewYorkJets})
-	return Scenario{
-		entries:   filtered,
-		schedules: sched.SplitToTeams(),
-	}
-}
-
-func Division2ClubsNetPoints() Scenario {
-	sched := schedule.NewSchedule()
-
-	// Pats 0-1, -5 net
-	// Jets 0-1, -10 net
-
-	// Week 1: Pats and Jets both lost, Pats better diff
-	patsAtCards := game.Game{
-		Winner:  team.ArizonaCardinals.Name,
-		Loser:   team.NewEnglandPatriots.Name,
-		Home:    team.ArizonaCardinals.Name,
-		Away:    team.NewEnglandPatriots.Name,
-		PtsWin:  30,
-		PtsLose: 25,
-	}
-	jetsAtRams := game.Game{
-		Winner:  team.LosAngelesRams.Name,
-		Loser:   team.NewYorkJets.Name,
-		Home:    team.LosAngelesRams.Name,
-		Away:    team.NewYorkJets.Name,
-		PtsWin:  20,
-		PtsLose: 10,
-	}
-	sched.AddGame(1, patsAtCards)
-	sched.AddGame(1, jetsAtRams)
-
-	entries := schedule.CreateEntries(sched)
-	// Filter so only the tied teams we care about are sorted
-	filtered := entry.FilterEntries(entries, []team.Team{team.NewEnglandPatriots, team.NewYorkJets})
-	return Scenario{
-		entries:   filtered,
-		schedules: sched.SplitToTeams(),
-	}
-}
-
-func ConferenceRecord(t1, t2 team.Team) Scenario {
-	sched := schedule.NewSchedule()
-
-	// Conference record is only used as a tiebreaker when the two teams are from the same conference
-	if t1.Conference != t2.Conference {
-		// TODO: Don't panic
-		panic(fmt.Sprintf("Teams not from the same conference: %s, %s", t1.Name, t2.Name))
-	}
-
-	// If the teams are from the same division, make sure to pick conference teams NOT from
-	// that division, otherwise the division record tiebreaker might kick in before this one
-	//
-	// Not an issue if the teams are not from the same division, as the wild card tiebreaker
-	// does not use division record
-	var noDiv []string
-	if t1.Division == t2.Division {
-		noDiv = append(noDiv, t1.Division)
-	}
-
-	// Find 4 other teams, 2 from the same conference and 2 from the other
-	var otherConf string
-	if t1.Conference == team.AFC {
-		otherConf = team.NFC
-	} else {
-		otherConf = team.AFC
-	}
-
-	confOpps := team.GetRandomTeams(2, nil, noDiv, otherConf)
-	nonConfOpps := team.GetRandomTeams(2, nil, nil, t1.Conference)
-
-	// t1 | 1-1 | 1-0 v conf
-	// t2 | 1-1 | 0-1 v conf
-
-	// Week 1: Both teams win, and t1's game is in-conference
-	t1AtConf := game.Game{
-		Winner: t1.Name,
-		Loser:  confOpps[0].Name,
-		Home:   confOpps[0].Name,
-		Away:   t1.Name,
-	}
-	t2AtNonConf := game.Game{
-		Winner: t2.Name,
-		Loser:  nonConfOpps[0].Name,
-		Home:   nonConfOpps[0].Name,
-		Away:   t2.Name,
-	}
-	sched.AddGame(1, t1AtConf)
-	sched.AddGame(1, t2AtNonConf)
-
-	// Week 2: Both teams lose, and t2's game is in-conference
-	t1AtNonConf := game.Game{
-		Winner: nonConfOpps[1].Name,
-		Loser:  t1.Name,
-		Home:   nonConfOpps[1].Name,
-		Away:   t1.Name,
-	}
-	t2AtConf := game.Game{
-		Winner: confOpps[1].Name,
-		Loser:  t2.Name,
-		Home:   confOpps[1].Name,
-		Away:   t2.Name,
-	}
-	sched.AddGame(2, t1AtNonConf)
-	sched.AddGame(2, t2AtConf)
-
-	entries := schedule.CreateEntries(sched)
-	// Filter so only the tied teams we care about are sorted
-	filtered := entry.FilterEntries(entries, []team.Team{t1, t2})
-	return Scenario{
-		entries:   filtered,
-		schedules: sched.SplitToTeams(),
-	}
-}
-
-func Conference2ClubsCommonGames() Scenario {
-	sched := schedule.NewSchedule()
-
-	// Need 4 common games. They will each play
-	// Texans, Steelers, Cardinals, Rams
-
-	// Pats    3-2, 3-1 v common, 0-1 v other
-	// Raiders 3-2, 2-2 v common, 1-0 v other
-
-	// Week 1: Pats lose to other, Raiders win against other
-	patsAtBroncos := game.Game{
-		Winner: team.DenverBroncos.Name,
-		Loser:  team.NewEnglandPatriots.Name,
-		Home:   team.DenverBroncos.Name,
-		Away:   team.NewEnglandPatriots.Name,
-	}
-	raidersAtJets := game.Game{
-		Winner: team.LasVegasRaiders.Name,
-		Loser:  team.NewYorkJets.Name,
-		Home:   team.NewYorkJets.Name,
-		Away:   team.LasVegasRaiders.Name,
-	}
-	sched.AddGame(1, patsAtBroncos)
-	sched.AddGame(1, raidersAtJets)
-
-	// Week 2: Pats win v common, Raiders lose v common
-	texansAtPats := game.Game{
-		Winner: team.NewEnglandPatriots.Name,
-		Loser:  team.HoustonTexans.Name,
-		Home:   team.NewEnglandPatriots.Name,
-		Away:   team.HoustonTexans.Name,
-	}
-	steelersAtRaiders := game.Game{
-		Winner: team.PittsburghSteelers.Name,
-		Loser:  team.LasVegasRaiders.Name,
-		Home:   team.LasVegasRaiders.Name,
-		Away:   team.PittsburghSteelers.Name,
-	}
-	sched.AddGame(2, texansAtPats)
-	sched.AddGame(2, steelersAtRaiders)
-
-	// Weeks 3-5: Pats and Raiders both win v remaining common
-	steelersAtPats := game.Game{
-		Winner: team.NewEnglandPatriots.Name,
-		Loser:  team.PittsburghSteelers.Name,
-		Home:   team.NewEnglandPatriots.Name,
-		Away:   team.PittsburghSteelers.Name,
-	}
-	texansAtRaiders := game.Game{
-		Winner: team.LasVegasRaiders.Name,
-		Loser:  team.HoustonTexans.Name,
-		Home:   team.LasVegasRaiders.Name,
-		Away:   team.HoustonTexans.Name,
-	}
-	sched.AddGame(3, steelersAtPats)
-	sched.AddGame(3, texansAtRaiders)
-
-	patsAtCards := game.Game{
-		Winner: team.NewEnglandPatriots.Name,
-		Loser:  team.ArizonaCardinals.Name,
-		Home:   team.ArizonaCardinals.Name,
-		Away:   team.NewEnglandPatriots.Name,
-	}
-	raidersAtRams := game.Game{
-		Winner: team.LasVegasRaiders.Name,
-		Loser:  team.LosAngelesRams.Name,
-		Home:   team.LosAngelesRams.Name,
-		Away:   team.LasVegasRaiders.Name,
-	}
-	sched.AddGame(4, patsAtCards)
-	sched.AddGame(4, raidersAtRams)
-
-	ramsAtPats := game.Game{
-		Winner: team.NewEnglandPatriots.Name,
-		Loser:  team.LosAngelesRams.Name,
-		Home:   team.NewEnglandPatriots.Name,
-		Away:   team.LosAngelesRams.Name,
-	}
-	cardsAtRaiders := game.Game{
-		Winner: team.LasVegasRaiders.Name,
-		Loser:  team.ArizonaCardinals.Name,
-		Home:   team.LasVegasRaiders.Name,
-		Away:   team.ArizonaCardinals.Name,
-	}
-	sched.AddGame(5, ramsAtPats)
-	sched.AddGame(5, cardsAtRaiders)
-
-	entries := schedule.CreateEntries(sched)
-	// Filter so only the tied teams we care about are sorted
-	filtered := entry.FilterEntries(entries, []team.Team{team.NewEnglandPatriots, team.LasVegasRaiders})
-	return Scenario{
-		entries:   filtered,
-		schedules: sched.SplitToTeams(),
-	}
-}
-
-func Conference2ClubsStrengthOfSchedule() Scenario {
-	sched := schedule.NewSchedule()
-
-	// Pats 1-1, Opponents 2-2
-	// Raiders 1-1, Opponents 1-3
-
-	// Week 1: Pats and Raiders both win.
-	// Seahawks (Pats future opponent) win, Rams (Raiders future opponent) lose
-	patsAtCards := game.Game{
-		Winner: team.NewEnglandPatriots.Name,
-		Loser:  team.ArizonaCardinals.Name,
-		Home:   team.ArizonaCardinals.Name,
-		Away:   team.NewEnglandPatriots.Name,
-	}
-	raidersAtGiants := game.Game{
-		Winner: team.LasVegasRaiders.Name,
-		Loser:  team.NewYorkGiants.Name,
-		Home:   team.NewYorkGiants.Name,
-		Away:   team.LasVegasRaiders.Name,
-	}
-	seahawksAtRams := game.Game{
-		Winner: team.SeattleSeahawks.Name,
-		Loser:  team.LosAngelesRams.Name,
-		Home:   team.LosAngelesRams.Name,
-		Away:   team.SeattleSeahawks.Name,
-	}
-	sched.AddGame(1, patsAtCards)
-	sched.AddGame(1, raidersAtGiants)
-	sched.AddGame(1, seahawksAtRams)
-
-	// Week 2: Pats and Raiders both lose. Pats to a better team
-	// Cardinals (Pats previous opponent) and Giants (Raiders previous opponent) both lose to keep SOV the same
-	seahawksAtPats := game.Game{
-		Winner: team.SeattleSeahawks.Name,
-		Loser:  team.NewEnglandPatriots.Name,
-		Home:   team.NewEnglandPatriots.Name,
-		Away:   team.SeattleSeahawks.Name,
-	}
-	ramsAtRaiders := game.Game{
-		Winner: team.LosAngelesRams.Name,
-		Loser:  team.LasVegasRaiders.Name,
-		Home:   team.LasVegasRaiders.Name,
-		Away:   team.LosAngelesRams.Name,
-	}
-	cardinalsAtPanthers := game.Game{
-		Winner: team.CarolinaPanthers.Name,
-		Loser:  team.ArizonaCardinals.Name,
-		Home:   team.CarolinaPanthers.Name,
-		Away:   team.ArizonaCardinals.Name,
-	}
-	giantsAtBears := game.Game{
-		Winner: team.ChicagoBears.Name,
-		Loser:  team.NewYorkGiants.Name,
-		Home:   team.ChicagoBears.Name,
-		Away:   team.NewYorkGiants.Name,
-	}
-	sched.AddGame(2, seahawksAtPats)
-	sched.AddGame(2, ramsAtRaiders)
-	sched.AddGame(2, cardinalsAtPanthers)
-	sched.AddGame(2, giantsAtBears)
-
-	entries := schedule.CreateEntries(sched)
-	// Filter so only the tied teams we care about are sorted
-	filtered := entry.FilterEntries(entries, []team.Team{team.NewEnglandPatriots, team.LasVegasRaiders})
-	return Scenario{
-		entries:   filtered,
-		schedules: sched.SplitToTeams(),
-	}
-}
-
-func Conference2ClubsConferenceRank() Scenario {
-	sched := schedule.NewSchedule()
-
-	// Pats    1-0, 30 points for, 20 points against
-	// Raiders 1-0, 29 points for, 21 points against
-
-	// Week 1: Pats and Raiders both win. Pats score more and allow less
-	patsAtCards := game.Game{
-		Winner:  team.NewEnglandPatriots.Name,
-		Loser:   team.ArizonaCardinals.Name,
-		Home:    team.ArizonaCardinals.Name,
-		Away:    team.NewEnglandPatriots.Name,
-		PtsWin:  30,
-		PtsLose: 20,
-	}
-	raidersAtGiants := game.Game{
-		Winner:  team.LasVegasRaiders.Name,
-		Loser:   team.NewYorkGiants.Name,
-		Home:    team.NewYorkGiants.Name,
-		Away:    team.LasVegasRaiders.Name,
-		PtsWin:  29,
-		PtsLose: 21,
-	}
-	sched.AddGame(1, patsAtCards)
-	sched.AddGame(1, raidersAtGiants)
-
-	entries := schedule.CreateEntries(sched)
-	// Filter so only the tied teams we care about are sorted
-	filtered := entry.FilterEntries(entries, []team.Team{team.NewEnglandPatriots, team.LasVegasRaiders})
-	return Scenario{
-		entries:   filtered,
-		schedules: sched.SplitToTeams(),
-	}
-}
+	Name string
 
-func Conference2ClubsLeagueRank() Scenario {
-	sched := schedule.NewSchedule()
+	// Want is the order the tied teams should be sorted in
+	Want []team.Team
 
-	// Points For:
-	// 1. Patriots  (AFC) - 40
-	// 2. Cardinals (NFC) - 30
-	// 3. Giants    (NFC) - 25
-	// 4. Raiders   (AFC) - 20
-	// 5. Bears     (NFC) - 16
+	// Tiebreaker is the name of the step that should separate the tied teams, empty to not check it
+	Tiebreaker string
 
-	// Points Against:
-	// 1. Bears     (NFC) - 15
-	// 1. Raiders   (AFC) - 17
-	// 2. Giants    (NFC) - 20
-	// 3. Patriots  (AFC) - 30
-	// 4. Cardinals (NFC) - 55
-
-	// Week 1:
-	patsAtCards := game.Game{
-		Winner:  team.NewEnglandPatriots.Name,
-		Loser:   team.ArizonaCardinals.Name,
-		Home:    team.ArizonaCardinals.Name,
-		Away:    team.NewEnglandPatriots.Name,
-		PtsWin:  40,
-		PtsLose: 30,
-	}
-	raidersAtGiants := game.Game{
-		Winner:  team.LasVegasRaiders.Name,
-		Loser:   team.NewYorkGiants.Name,
-		Home:    team.NewYorkGiants.Name,
-		Away:    team.LasVegasRaiders.Name,
-		PtsWin:  20,
-		PtsLose: 17,
-	}
-	sched.AddGame(1, patsAtCards)
-	sched.AddGame(1, raidersAtGiants)
-
-	// Week 2: Giants overtake Raiders in PF, lose to keep SOV/SOS same
-	giantsAtBears := game.Game{
-		Winner:  team.ChicagoBears.Name,
-		Loser:   team.NewYorkGiants.Name,
-		Home:    team.NewYorkGiants.Name,
-		Away:    team.ChicagoBears.Name,
-		PtsWin:  16,
-		PtsLose: 15,
-	}
-	sched.AddGame(2, giantsAtBears)
-
-	entries := schedule.CreateEntries(sched)
-	// Filter so only the tied teams we care about are sorted
-	filtered := entry.FilterEntries(entries, []team.Team{team.NewEnglandPatriots, team.LasVegasRaiders})
-	return Scenario{
-		entries:   filtered,
-		schedules: sched.SplitToTeams(),
-	}
-}
-
-func Conference2ClubsNetPointsConference() Scenario {
-	sched := schedule.NewSchedule()
-
-	// Pats    0-1, -5 net conference
-	// Raiders 0-1, -10 net conference
-
-	// Week 1: Pats and Raiders both lost, Pats better diff
-	patsAtSteelers := game.Game{
-		Winner:  team.PittsburghSteelers.Name,
-		Loser:   team.NewEnglandPatriots.Name,
-		Home:    team.PittsburghSteelers.Name,
-		Away:    team.NewEnglandPatriots.Name,
-		PtsWin:  30,
-		PtsLose: 25,
-	}
-	raidersAtBengals := game.Game{
-		Winner:  team.CincinnatiBengals.Name,
-		Loser:   team.LasVegasRaiders.Name,
-		Home:    team.CincinnatiBengals.Name,
-		Away:    team.LasVegasRaiders.Name,
-		PtsWin:  20,
-		PtsLose: 10,
-	}
-	sched.AddGame(1, patsAtSteelers)
-	sched.AddGame(1, raidersAtBengals)
-
-	entries := schedule.CreateEntries(sched)
-	// Filter so only the tied teams we care about are sorted
-	filtered := entry.FilterEntries(entries, []team.Team{team.NewEnglandPatriots, team.LasVegasRaiders})
-	return Scenario{
-		entries:   filtered,
-		schedules: sched.SplitToTeams(),
-	}
+	entries   []entry.Entry
+	schedules map[string]schedule.Schedule
 }
 
-func Conference2ClubsNetPoints() Scenario {
-	sched := schedule.NewSchedule()
-
-	// Pats   0-1, -5 net
-	// Raiders 0-1, -10 net
-
-	// Week 1: Pats and Raiders both lost (non-conference), Pats better diff
-	patsAtCards := game.Game{
-		Winner:  team.ArizonaCardinals.Name,
-		Loser:   team.NewEnglandPatriots.Name,
-		Home:    team.ArizonaCardinals.Name,
-		Away:    team.NewEnglandPatriots.Name,
-		PtsWin:  30,
-		PtsLose: 25,
-	}
-	raidersAtRams := game.Game{
-		Winner:  team.LosAngelesRams.Name,
-		Loser:   team.LasVegasRaiders.Name,
-		Home:    team.LosAngelesRams.Name,
-		Away:    team.LasVegasRaiders.Name,
-		PtsWin:  20,
-		PtsLose: 10,
-	}
-	sched.AddGame(1, patsAtCards)
-	sched.AddGame(1, raidersAtRams)
+var (
+	gameLine   = regexp.MustCompile(`^wk(\d+):\s*(.+?)\s*@\s*(.+?)\s+(\d+)-(\d+)$`)
+	expectLine = regexp.MustCompile(`^expect:\s*(.+?)(?:\s+by\s+(.+))?$`)
+)
 
-	entries := schedule.CreateEntries(sched)
-	// Filter so only the tied teams we care about are sorted
-	filtered := entry.FilterEntries(entries, []team.Team{team.NewEnglandPatriots, team.LasVegasRaiders})
-	return Scenario{
-		entries:   filtered,
-		schedules: sched.SplitToTeams(),
-	}
+// ParseScenarios reads every scenario in text
+func ParseScenarios(text string) ([]Scenario, error) {
+	scenarios := make([]Scenario, 0)
+	var current *Scenario
+	var sched schedule.Schedule
+
+	finish := func() error {
+		if current == nil {
+			return nil
+		}
+		if len(current.Want) < 2 {
+			return fmt.Errorf("scenario %q: expected at least two tied teams", current.Name)
+		}
+		current.entries = entry.FilterEntries(schedule.CreateEntries(sched), current.Want)
+		if len(current.entries) != len(current.Want) {
+			return fmt.Errorf("scenario %q: every tied team needs to have played a game", current.Name)
+		}
+		current.schedules = sched.SplitToTeams()
+		scenarios = append(scenarios, *current)
+		return nil
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(text))
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if name, ok := strings.CutPrefix(line, "scenario:"); ok {
+			if err := finish(); err != nil {
+				return nil, err
+			}
+			current = &Scenario{Name: strings.TrimSpace(name)}
+			sched = schedule.NewSchedule()
+			continue
+		}
+		if current == nil {
+			return nil, fmt.Errorf("line %d: expected a scenario: line first", n)
+		}
+
+		if m := gameLine.FindStringSubmatch(line); m != nil {
+			week, g, err := parseGame(m)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", n, err)
+			}
+			for _, other := range sched.Weeks[week-1].Games {
+				if other.Home == g.Home || other.Home == g.Away || other.Away == g.Home || other.Away == g.Away {
+					return nil, fmt.Errorf("line %d: a team already plays in week %d", n, week)
+				}
+			}
+			sched.AddGame(week, g)
+			continue
+		}
+
+		if m := expectLine.FindStringSubmatch(line); m != nil {
+			if current.Want != nil {
+				return nil, fmt.Errorf("line %d: scenario %q already has an expect line", n, current.Name)
+			}
+			current.Want = make([]team.Team, 0)
+			for _, name := range strings.Split(m[1], ",") {
+				t, ok := team.Lookup(strings.TrimSpace(name))
+				if !ok {
+					return nil, fmt.Errorf("line %d: unknown team %q", n, strings.TrimSpace(name))
+				}
+				if slices.Contains(current.Want, t) {
+					return nil, fmt.Errorf("line %d: %s is listed twice", n, t.Name)
+				}
+				current.Want = append(current.Want, t)
+			}
+			current.Tiebreaker = m[2]
+			continue
+		}
+
+		return nil, fmt.Errorf("line %d: cannot read %q", n, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if err := finish(); err != nil {
+		return nil, err
+	}
+	return scenarios, nil
+}
+
+// parseGame turns the parts of a game line into its week and game
+func parseGame(m []string) (int, game.Game, error) {
+	week, _ := strconv.Atoi(m[1])
+	if week < 1 || week > 18 {
+		return 0, game.Game{}, fmt.Errorf("week %d is outside of the season", week)
+	}
+	away, ok := team.Lookup(m[2])
+	if !ok {
+		return 0, game.Game{}, fmt.Errorf("unknown team %q", m[2])
+	}
+	home, ok := team.Lookup(m[3])
+	if !ok {
+		return 0, game.Game{}, fmt.Errorf("unknown team %q", m[3])
+	}
+	if away == home {
+		return 0, game.Game{}, fmt.Errorf("%s cannot play itself", away.Name)
+	}
+	awayPts, _ := strconv.Atoi(m[4])
+	homePts, _ := strconv.Atoi(m[5])
+
+	g := game.Game{Home: home.Name, Away: away.Name}
+	switch {
+	case awayPts > homePts:
+		g.Winner, g.Loser, g.PtsWin, g.PtsLose = away.Name, home.Name, awayPts, homePts
+	case homePts > awayPts:
+		g.Winner, g.Loser, g.PtsWin, g.PtsLose = home.Name, away.Name, homePts, awayPts
+	default:
+		// Ties keep the home team's points in PtsWin
+		g.PtsWin, g.PtsLose = homePts, awayPts
+	}
+	return week, g, nil
+}
+
+// Check sorts the scenario's tied teams and returns an error describing how the result differs from what is expected
+func (s Scenario) Check() error {
+	got, trace, err := SortEntriesTrace(s.entries, s.schedules)
+	if err != nil {
+		return err
+	}
+
+	if !orderMatches(got, s.Want) {
+		return fmt.Errorf("got %s, want %s\n%s", entry.Names(got), team.Names(s.Want), trace)
+	}
+
+	if s.Tiebreaker != "" {
+		teams := make([]string, len(s.Want))
+		for i, t := range s.Want {
+			teams[i] = t.Name
+		}
+		step, ok := trace.Deciding(teams)
+		if !ok {
+			return fmt.Errorf("no step separated the tied teams\n%s", trace)
+		}
+		if !strings.EqualFold(step.Tiebreaker, s.Tiebreaker) {
+			return fmt.Errorf("separated by %s, want %s\n%s", step.Tiebreaker, s.Tiebreaker, trace)
+		}
+	}
+	return nil
+}
+
+func orderMatches(entries []entry.Entry, teams []team.Team) bool {
+	if len(entries) != len(teams) {
+		return false
+	}
+	for i := range entries {
+		if entries[i].Team.Name != teams[i].Name {
+			return false
+		}
+	}
+	return true
 }
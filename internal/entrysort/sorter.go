@@ -17,10 +17,11 @@ type Sorter struct {
 	Tiebreaker *Sorter
 
 	TiebreakMethod string // "subgroup" | "elimination" |  "double elimination" | "triple elimination"
-
-	// Add a cache for recent results?
 }
 
+// coinToss is the name of the last tiebreaker, the only one whose result depends on the order teams are given in
+const coinToss = "Coin Toss"
+
 const (
 	// Tiebreak methods
 	subgroup          = "subgroup"
@@ -29,6 +30,47 @@ const (
 	tripleElimination = "triple elimination"
 )
 
+// Basis is what a tiebreaker's values are computed from
+type Basis int
+
+const (
+	// OwnRecords is the won-lost-tied records of the teams being compared, over all their games or some of them
+	OwnRecords Basis = iota
+
+	// OpponentRecords is the won-lost-tied records of the teams' opponents
+	OpponentRecords
+
+	// Scores is points scored and allowed, or for a coin toss nothing at all
+	Scores
+)
+
+// recordTiebreakers are the tiebreakers decided by the records of the teams being compared
+var recordTiebreakers = map[string]bool{
+	"Division 2 Clubs":    true,
+	"Division 3 Clubs":    true,
+	"Conference 2 Clubs":  true,
+	"Conference 3+ Clubs": true,
+	"League 2 Clubs":      true,
+	"League 3 Clubs":      true,
+	"Head to Head":        true,
+	"Head to Head sweep":  true,
+	"Division Record":     true,
+	"Common Games":        true,
+	"Conference Record":   true,
+}
+
+// TiebreakerBasis returns what the named tiebreaker is computed from
+// Anything that is not a record or strength of victory or schedule is counted as Scores
+func TiebreakerBasis(name string) Basis {
+	switch {
+	case recordTiebreakers[name]:
+		return OwnRecords
+	case name == "Strength of Victory" || name == "Strength of Schedule":
+		return OpponentRecords
+	}
+	return Scores
+}
+
 // According to NFL tiebreaker rules (https://www.nfl.com/standings/tie-breaking-procedures),
 // there are X sorting groups to consider
 // 2 clubs - Within division
@@ -157,7 +199,7 @@ func WithinDivisionTwoClubsSorter() *Sorter {
 	// TODO: touchdowns
 
 	coinTossSorter := &Sorter{
-		Name:           "Coin Toss",
+		Name:           coinToss,
 		SortByMap:      CoinTossMap,
 		Tiebreaker:     nil,
 		TiebreakMethod: elimination,
@@ -260,7 +302,7 @@ func WithinDivisionThreeClubsSorter() *Sorter {
 	// TODO: touchdowns
 
 	coinTossSorter := &Sorter{
-		Name:           "Coin Toss",
+		Name:           coinToss,
 		SortByMap:      CoinTossMap,
 		Tiebreaker:     nil,
 		TiebreakMethod: elimination,
@@ -357,7 +399,7 @@ func WithinConferenceTwoClubsSorter() *Sorter {
 	// TODO: touchdowns
 
 	coinTossSorter := &Sorter{
-		Name:           "Coin Toss",
+		Name:           coinToss,
 		SortByMap:      CoinTossMap,
 		Tiebreaker:     nil,
 		TiebreakMethod: elimination,
@@ -454,7 +496,7 @@ func WithinConferenceThreeClubsSorter() *Sorter {
 	// TODO: touchdowns
 
 	coinTossSorter := &Sorter{
-		Name:           "Coin Toss",
+		Name:           coinToss,
 		SortByMap:      CoinTossMap,
 		Tiebreaker:     nil,
 		TiebreakMethod: doubleElimination,
@@ -524,7 +566,7 @@ func WithinLeagueTwoClubsSorter() *Sorter {
 	// TODO: touchdowns
 
 	coinTossSorter := &Sorter{
-		Name:           "Coin Toss",
+		Name:           coinToss,
 		SortByMap:      CoinTossMap,
 		Tiebreaker:     nil,
 		TiebreakMethod: elimination,
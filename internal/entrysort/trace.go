@@ -0,0 +1,111 @@
+package entrysort
+
+import (
+	"fmt"
+	"nfl-app/internal/entry"
+	"sort"
+	"strings"
+)
+
+// Trace records every step taken while sorting a group of entries, in the order they were taken
+type Trace struct {
+	Steps []*Step `json:"steps"`
+}
+
+// Step is a single tiebreaker applied to a group of teams
+type Step struct {
+	// Depth is how deeply nested the step is. Steps taken to break a tie left by a step are one level deeper
+	Depth int `json:"depth"`
+
+	// Tiebreaker is the name of the Sorter applied
+	Tiebreaker string `json:"tiebreaker"`
+
+	Teams  []string           `json:"teams"`
+	Values map[string]float64 `json:"values"`
+
+	// Outcome describes what the step decided
+	Outcome string `json:"outcome"`
+
+	// Separated is true when the step decided a position on its own, rather than passing the tie on
+	Separated bool `json:"separated"`
+}
+
+// begin records the start of a step. Safe to call on a nil Trace, in which case nothing is recorded
+func (t *Trace) begin(depth int, tiebreaker string, entries []entry.Entry, values map[string]float64) *Step {
+	if t == nil {
+		return nil
+	}
+
+	teams := make([]string, len(entries))
+	for i, e := range entries {
+		teams[i] = e.Team.Name
+	}
+
+	step := &Step{
+		Depth:      depth,
+		Tiebreaker: tiebreaker,
+		Teams:      teams,
+		Values:     values,
+	}
+	t.Steps = append(t.Steps, step)
+	return step
+}
+
+func (s *Step) separate(outcome string) {
+	if s == nil {
+		return
+	}
+	s.Outcome = outcome
+	s.Separated = true
+}
+
+func (s *Step) tied() {
+	if s == nil {
+		return
+	}
+	s.Outcome = "tied, moving to the next tiebreaker"
+}
+
+func (s *Step) note(outcome string) {
+	if s == nil {
+		return
+	}
+	s.Outcome = outcome
+}
+
+// Deciding returns the step that separated exactly the given teams, if any
+// This is the tiebreaker that decided the order of that group
+func (t *Trace) Deciding(teams []string) (*Step, bool) {
+	want := strings.Join(sortedCopy(teams), "|")
+	for _, step := range t.Steps {
+		if step.Separated && strings.Join(sortedCopy(step.Teams), "|") == want {
+			return step, true
+		}
+	}
+	return nil, false
+}
+
+func sortedCopy(in []string) []string {
+	out := append([]string(nil), in...)
+	sort.Strings(out)
+	return out
+}
+
+// String renders the trace as an indented list of steps
+func (t *Trace) String() string {
+	var b strings.Builder
+	for _, step := range t.Steps {
+		b.WriteString(step.String())
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+func (s *Step) String() string {
+	values := make([]string, len(s.Teams))
+	for i, team := range s.Teams {
+		values[i] = fmt.Sprintf("%s %.3f", team, s.Values[team])
+	}
+
+	return fmt.Sprintf("%s%s: %s -> %s", strings.Repeat("  ", s.Depth), s.Tiebreaker, strings.Join(values, ", "), s.Outcome)
+}
@@ -4,6 +4,9 @@ import (
 	"time"
 )
 
+// Game is a single game between two teams
+// Ties are recorded with an empty Winner and Loser. In that case the *Win fields belong to
+// the home team and the *Lose fields to the away team
 type Game struct {
 	Time time.Time
 
@@ -23,5 +26,18 @@ type Game struct {
 	ToLose int
 }
 
+// IsTie reports whether the game ended in a tie
+func (g *Game) IsTie() bool {
+	return g.Winner == "" && g.Loser == ""
+}
+
+// Opponent returns the team that played against the given team
+func (g *Game) Opponent(team string) string {
+	if g.Home == team {
+		return g.Away
+	}
+	return g.Home
+}
+
 // TODO:
 // game.Validate() make sure no negative values, two unique teams, etc
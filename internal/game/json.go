@@ -0,0 +1,81 @@
+package game
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// gameJSON is the JSON shape of a Game. Field names follow the Game fields, so for a tie winner and loser
+// are empty and the *Win fields belong to the home team. time is RFC 3339 and left out when unknown
+type gameJSON struct {
+	Time *time.Time `json:"time,omitempty"`
+
+	Home   string `json:"home"`
+	Away   string `json:"away"`
+	Winner string `json:"winner"`
+	Loser  string `json:"loser"`
+
+	PtsWin    int `json:"ptsWin"`
+	PtsLose   int `json:"ptsLose"`
+	YardsWin  int `json:"yardsWin"`
+	YardsLose int `json:"yardsLose"`
+	ToWin     int `json:"toWin"`
+	ToLose    int `json:"toLose"`
+}
+
+// MarshalJSON encodes the game
+func (g Game) MarshalJSON() ([]byte, error) {
+	v := gameJSON{
+		Home:      g.Home,
+		Away:      g.Away,
+		Winner:    g.Winner,
+		Loser:     g.Loser,
+		PtsWin:    g.PtsWin,
+		PtsLose:   g.PtsLose,
+		YardsWin:  g.YardsWin,
+		YardsLose: g.YardsLose,
+		ToWin:     g.ToWin,
+		ToLose:    g.ToLose,
+	}
+	if !g.Time.IsZero() {
+		v.Time = &g.Time
+	}
+	return json.Marshal(v)
+}
+
+// UnmarshalJSON decodes a game encoded by MarshalJSON
+// The winner and loser must be the two teams playing, or both be empty for a tie
+func (g *Game) UnmarshalJSON(data []byte) error {
+	var v gameJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	played := func(t string) bool { return t == v.Home || t == v.Away }
+	switch {
+	case v.Home == "" || v.Away == "":
+		return fmt.Errorf("game needs a home and away team, got %q and %q", v.Home, v.Away)
+	case (v.Winner == "") != (v.Loser == ""):
+		return fmt.Errorf("%s@%s: winner and loser must both be given, or both be empty for a tie", v.Away, v.Home)
+	case v.Winner != "" && (!played(v.Winner) || !played(v.Loser) || v.Winner == v.Loser):
+		return fmt.Errorf("%s@%s: winner %q and loser %q must be the two teams playing", v.Away, v.Home, v.Winner, v.Loser)
+	}
+
+	*g = Game{
+		Home:      v.Home,
+		Away:      v.Away,
+		Winner:    v.Winner,
+		Loser:     v.Loser,
+		PtsWin:    v.PtsWin,
+		PtsLose:   v.PtsLose,
+		YardsWin:  v.YardsWin,
+		YardsLose: v.YardsLose,
+		ToWin:     v.ToWin,
+		ToLose:    v.ToLose,
+	}
+	if v.Time != nil {
+		g.Time = *v.Time
+	}
+	return nil
+}
@@ -0,0 +1,211 @@
+// Package golden checks the whole pipeline, from a saved games page through the scraper, the schedule, the
+// tiebreakers and seeding, against the official final standings, seeds and draft order of past seasons
+//
+// Each season is a directory under testdata/seasons named after the year, holding the season's games and the
+// official results to compare against:
+//
+//	testdata/seasons/2023/games.html     the games page saved from pro-football-reference.com (or games.csv,
+//	                                     as written by the CLI's --cache flag)
+//	testdata/seasons/2023/expected.json  the official results, see expected
+//
+// Only what expected.json lists is checked, so a season can start with just its seeds and grow from there
+package golden
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"nfl-app/internal/draft"
+	"nfl-app/internal/playoff"
+	"nfl-app/internal/schedule"
+	"nfl-app/internal/scraper"
+	"nfl-app/internal/standings"
+	"nfl-app/internal/team"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+// expected is the shape of expected.json. Teams may be given by anything team.Lookup accepts, such as "BUF"
+type expected struct {
+	// Divisions lists the teams of a division in their final order, keyed by division name ("AFC East")
+	Divisions map[string][]string `json:"divisions"`
+
+	// Seeds lists the seven playoff teams of a conference in seed order, keyed by "AFC" or "NFC"
+	Seeds map[string][]string `json:"seeds"`
+
+	// DraftOrder lists the first picks of the following draft by the team originally holding them,
+	// before any trades or forfeited picks
+	DraftOrder []string `json:"draftOrder"`
+}
+
+func TestSeasons(t *testing.T) {
+	dirs, err := filepath.Glob(filepath.Join("testdata", "seasons", "*"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dirs) == 0 {
+		t.Fatal("no season snapshots in testdata/seasons, see the package comment for how to add one")
+	}
+
+	for _, dir := range dirs {
+		t.Run(filepath.Base(dir), func(t *testing.T) {
+			checkSeason(t, dir)
+		})
+	}
+}
+
+// TestHarness runs the harness over a small made up season, so the saved page path is covered as well as games.csv
+func TestHarness(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "2023")
+	if err := os.Mkdir(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	// The Bills beat everyone in the AFC East, the Jets beat the Dolphins and Patriots and the Dolphins beat the Patriots
+	rows := []scraper.ScrapedRow{
+		{Week: "1", Winner: "Buffalo Bills", Loser: "Miami Dolphins", PtsWin: "24", PtsLose: "17"},
+		{Week: "1", Winner: "New York Jets", Loser: "New England Patriots", PtsWin: "20", PtsLose: "10"},
+		{Week: "2", Winner: "Buffalo Bills", GameLocation: "@", Loser: "New York Jets", PtsWin: "27", PtsLose: "3"},
+		{Week: "2", Winner: "Miami Dolphins", Loser: "New England Patriots", PtsWin: "31", PtsLose: "14"},
+		{Week: "3", Winner: "Buffalo Bills", Loser: "New England Patriots", PtsWin: "17", PtsLose: "13"},
+		{Week: "3", Winner: "New York Jets", GameLocation: "@", Loser: "Miami Dolphins", PtsWin: "23", PtsLose: "20"},
+	}
+	// Write the rows the way the games page lays them out, so the scraper is part of the run
+	page := "<html><body><table id=\"games\"><tbody>\n"
+	for _, r := range rows {
+		page += fmt.Sprintf(`<tr><th data-stat="week_num">%s</th><td data-stat="winner">%s</td>`+
+			`<td data-stat="game_location">%s</td><td data-stat="loser">%s</td>`+
+			`<td data-stat="pts_win">%s</td><td data-stat="pts_lose">%s</td></tr>`+"\n",
+			r.Week, r.Winner, r.GameLocation, r.Loser, r.PtsWin, r.PtsLose)
+	}
+	page += "</tbody></table></body></html>\n"
+	if err := os.WriteFile(filepath.Join(dir, "games.html"), []byte(page), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"divisions": {"AFC East": ["BUF", "NYJ", "MIA", "NE"]}}`
+	if err := os.WriteFile(filepath.Join(dir, "expected.json"), []byte(want), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	checkSeason(t, dir)
+}
+
+func checkSeason(t *testing.T, dir string) {
+	t.Helper()
+
+	year, err := strconv.Atoi(filepath.Base(dir))
+	if err != nil {
+		t.Fatalf("season directory %s is not named after a year", dir)
+	}
+	rows, err := readGames(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := readExpected(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	regular, playoffs := scraper.SplitPlayoffs(rows)
+	sched := schedule.CreateSchedule(regular)
+	st, err := standings.Rank(sched)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for div, teams := range want.Divisions {
+		got := make([]string, 0)
+		for _, e := range st.Division(div) {
+			got = append(got, e.Team.Name)
+		}
+		if !slices.Equal(got, teams) {
+			t.Errorf("%s:\n got %v\nwant %v", div, got, teams)
+		}
+	}
+
+	for conf, teams := range want.Seeds {
+		c, ok := team.LookupConference(conf)
+		if !ok {
+			t.Fatalf("unknown conference %q in expected.json", conf)
+		}
+		got := make([]string, 0)
+		for _, e := range st.Conference(c) {
+			if e.Stats.Seed <= playoff.SeedsPerConference {
+				got = append(got, e.Team.Name)
+			}
+		}
+		if !slices.Equal(got, teams) {
+			t.Errorf("%s seeds:\n got %v\nwant %v", conf, got, teams)
+		}
+	}
+
+	if len(want.DraftOrder) > 0 {
+		season, err := draft.FromSeason(year, sched, playoff.GamesFromRows(playoffs))
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := make([]string, 0)
+		for _, e := range season.Order[:min(len(want.DraftOrder), len(season.Order))] {
+			got = append(got, e.Team.Name)
+		}
+		if !slices.Equal(got, want.DraftOrder) {
+			t.Errorf("draft order:\n got %v\nwant %v", got, want.DraftOrder)
+		}
+	}
+}
+
+// readGames reads games.html, or games.csv when there is no saved page
+func readGames(dir string) ([]scraper.ScrapedRow, error) {
+	rows, err := scraper.ScrapeFile(filepath.Join(dir, "games.html"))
+	if !errors.Is(err, os.ErrNotExist) {
+		return rows, err
+	}
+
+	f, err := os.Open(filepath.Join(dir, "games.csv"))
+	if err != nil {
+		return nil, fmt.Errorf("no games.html or games.csv in %s", dir)
+	}
+	defer f.Close()
+	return scraper.ReadCSV(f)
+}
+
+// readExpected reads expected.json, resolving every team to its full name
+func readExpected(dir string) (expected, error) {
+	data, err := os.ReadFile(filepath.Join(dir, "expected.json"))
+	if err != nil {
+		return expected{}, err
+	}
+	var want expected
+	if err := json.Unmarshal(data, &want); err != nil {
+		return expected{}, fmt.Errorf("reading expected.json: %w", err)
+	}
+
+	resolve := func(names []string) error {
+		for i, name := range names {
+			t, ok := team.Lookup(name)
+			if !ok {
+				return fmt.Errorf("unknown team %q in expected.json", name)
+			}
+			names[i] = t.Name
+		}
+		return nil
+	}
+	for _, teams := range want.Divisions {
+		if err := resolve(teams); err != nil {
+			return expected{}, err
+		}
+	}
+	for _, teams := range want.Seeds {
+		if err := resolve(teams); err != nil {
+			return expected{}, err
+		}
+	}
+	if err := resolve(want.DraftOrder); err != nil {
+		return expected{}, err
+	}
+	return want, nil
+}
@@ -0,0 +1,342 @@
+package playoff
+
+import (
+	"fmt"
+	"nfl-app/internal/entry"
+	"nfl-app/internal/game"
+	"nfl-app/internal/schedule"
+	"nfl-app/internal/scraper"
+	"nfl-app/internal/team"
+	"slices"
+	"sort"
+)
+
+const (
+	// Rounds
+	WildCard               = "Wild Card"
+	Divisional             = "Divisional"
+	ConferenceChampionship = "Conference Championship"
+	SuperBowl              = "Super Bowl"
+
+	// SeedsPerConference is the number of teams from each conference that make the playoffs
+	SeedsPerConference = 7
+
+	// firstSuperBowlSeason is the season that ended with Super Bowl I
+	firstSuperBowlSeason = 1966
+)
+
+// Rounds in the order they are played
+var Rounds = []string{WildCard, Divisional, ConferenceChampionship, SuperBowl}
+
+// Matchup is a single playoff game
+type Matchup struct {
+	Round string
+
+	// Conference is empty for the Super Bowl
+	Conference string
+
+	// Home is the higher seed, except in the Super Bowl where it is the designated home team
+	Home entry.Entry
+	Away entry.Entry
+
+	// Neutral is true when the game is not played at the home team's stadium
+	Neutral bool
+
+	// Winner is the name of the winning team, empty until a result is added
+	Winner string
+}
+
+// Decided reports whether a result has been added for the matchup
+func (m *Matchup) Decided() bool {
+	return m.Winner != ""
+}
+
+// Involves reports whether the given team plays in the matchup
+func (m *Matchup) Involves(teamname string) bool {
+	return m.Home.Team.Name == teamname || m.Away.Team.Name == teamname
+}
+
+// WinnerEntry returns the entry of the winning team
+func (m *Matchup) WinnerEntry() entry.Entry {
+	if m.Winner == m.Home.Team.Name {
+		return m.Home
+	}
+	return m.Away
+}
+
+// Bracket models the postseason for a single season, starting from the seeded entries of each conference
+// Results are added round by round, and the next round is built (and reseeded) once every game in
+// the current round has a winner
+type Bracket struct {
+	Season int
+
+	// Seeds holds the 7 playoff teams for each conference, ordered by seed
+	Seeds map[string][]entry.Entry
+
+	// Games holds the matchups built so far for each round
+	Games map[string][]Matchup
+
+	// round is the index into Rounds of the round currently being played
+	round int
+}
+
+// NewBracket creates a bracket from seeded conference entries and builds the Wild Card matchups
+// Entries are expected to have Stats.Seed populated (see entrysort.SeedEntries). Only seeds 1-7 are used
+func NewBracket(season int, seeded map[string][]entry.Entry) (*Bracket, error) {
+	seeds := make(map[string][]entry.Entry)
+	for _, conf := range team.Conferences {
+		entries, err := playoffTeams(seeded[conf])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", conf, err)
+		}
+		seeds[conf] = entries
+	}
+
+	b := &Bracket{
+		Season: season,
+		Seeds:  seeds,
+		Games:  make(map[string][]Matchup),
+	}
+	b.buildRound()
+
+	return b, nil
+}
+
+// playoffTeams returns the entries seeded 1-7, ordered by seed
+func playoffTeams(entries []entry.Entry) ([]entry.Entry, error) {
+	out := make([]entry.Entry, 0, SeedsPerConference)
+	for _, e := range entries {
+		if e.Stats.Seed >= 1 && e.Stats.Seed <= SeedsPerConference {
+			out = append(out, e)
+		}
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Stats.Seed < out[j].Stats.Seed
+	})
+
+	if len(out) != SeedsPerConference {
+		return nil, fmt.Errorf("expected %d seeded entries, got %d", SeedsPerConference, len(out))
+	}
+	for i, e := range out {
+		if e.Stats.Seed != i+1 {
+			return nil, fmt.Errorf("seed %d is missing or duplicated", i+1)
+		}
+	}
+
+	return out, nil
+}
+
+// Round returns the name of the round currently being played
+// Once the Super Bowl is decided, this remains SuperBowl
+func (b *Bracket) Round() string {
+	return Rounds[b.round]
+}
+
+// Matchups returns the matchups for the given round. Rounds that have not been reached yet are empty
+func (b *Bracket) Matchups(round string) []Matchup {
+	return b.Games[round]
+}
+
+// Byes returns the teams with a first-round bye (the 1 seed of each conference)
+func (b *Bracket) Byes() []entry.Entry {
+	out := make([]entry.Entry, 0, len(team.Conferences))
+	for _, conf := range team.Conferences {
+		out = append(out, b.Seeds[conf][0])
+	}
+	return out
+}
+
+// AddResult records the outcome of a playoff game in the current round
+// The game is matched to a matchup by the two teams involved. Once every game
+// in the round has a winner, the next round is built
+func (b *Bracket) AddResult(g game.Game) error {
+	if g.Winner == "" || g.Loser == "" || g.Winner == g.Loser {
+		return fmt.Errorf("playoff game must have a distinct winner and loser: %s vs %s", g.Winner, g.Loser)
+	}
+
+	round := b.Round()
+	matchups := b.Games[round]
+	for i := range matchups {
+		m := &matchups[i]
+		if !m.Involves(g.Winner) || !m.Involves(g.Loser) {
+			continue
+		}
+		if m.Decided() {
+			return fmt.Errorf("%s matchup between %s and %s already decided", round, g.Winner, g.Loser)
+		}
+
+		m.Winner = g.Winner
+		if b.roundComplete() && b.round < len(Rounds)-1 {
+			b.round++
+			b.buildRound()
+		}
+		return nil
+	}
+
+	return fmt.Errorf("no %s matchup between %s and %s", round, g.Winner, g.Loser)
+}
+
+// AddResults records a series of playoff games in order. Games must be given round by round
+func (b *Bracket) AddResults(games []game.Game) error {
+	for _, g := range games {
+		if err := b.AddResult(g); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// HomeTeam returns the team hosting the game between the two given teams in the current round, and whether the site is neutral
+func (b *Bracket) HomeTeam(t1, t2 string) (string, bool, error) {
+	for _, m := range b.Games[b.Round()] {
+		if m.Involves(t1) && m.Involves(t2) {
+			return m.Home.Team.Name, m.Neutral, nil
+		}
+	}
+	return "", false, fmt.Errorf("no %s matchup between %s and %s", b.Round(), t1, t2)
+}
+
+// SuperBowlMatchup returns the Super Bowl pairing, if the conference championships have been decided
+func (b *Bracket) SuperBowlMatchup() (Matchup, bool) {
+	games := b.Games[SuperBowl]
+	if len(games) == 0 {
+		return Matchup{}, false
+	}
+	return games[0], true
+}
+
+// Champion returns the Super Bowl winner, if it has been decided
+func (b *Bracket) Champion() (entry.Entry, bool) {
+	m, ok := b.SuperBowlMatchup()
+	if !ok || !m.Decided() {
+		return entry.Entry{}, false
+	}
+	return m.WinnerEntry(), true
+}
+
+// Remaining returns the teams still alive in the given conference, ordered by seed
+func (b *Bracket) Remaining(conference string) []entry.Entry {
+	alive := slices.Clone(b.Seeds[conference])
+
+	for _, round := range Rounds[:b.round] {
+		for _, m := range b.Games[round] {
+			if m.Conference != conference || !m.Decided() {
+				continue
+			}
+			loser := m.Home.Team.Name
+			if m.Winner == loser {
+				loser = m.Away.Team.Name
+			}
+			alive = slices.DeleteFunc(alive, func(e entry.Entry) bool {
+				return e.Team.Name == loser
+			})
+		}
+	}
+
+	return alive
+}
+
+// Exits returns the number of the round (1 for the Wild Card round) each eliminated team lost in
+// The Super Bowl champion is given len(Rounds)+1. Teams still alive are not included
+func (b *Bracket) Exits() map[string]int {
+	out := make(map[string]int)
+	for i, round := range Rounds {
+		for _, m := range b.Games[round] {
+			if !m.Decided() {
+				continue
+			}
+			loser := m.Home.Team.Name
+			if m.Winner == loser {
+				loser = m.Away.Team.Name
+			}
+			out[loser] = i + 1
+		}
+	}
+
+	if champ, ok := b.Champion(); ok {
+		out[champ.Team.Name] = len(Rounds) + 1
+	}
+
+	return out
+}
+
+func (b *Bracket) roundComplete() bool {
+	for _, m := range b.Games[b.Round()] {
+		if !m.Decided() {
+			return false
+		}
+	}
+	return true
+}
+
+// buildRound creates the matchups for the current round from the teams still alive
+// Every round is reseeded, so the top remaining seed always plays the lowest remaining seed
+func (b *Bracket) buildRound() {
+	round := b.Round()
+
+	if round == SuperBowl {
+		afc := b.Remaining(team.AFC)[0]
+		nfc := b.Remaining(team.NFC)[0]
+
+		home, away := nfc, afc
+		if b.afcDesignatedHome() {
+			home, away = afc, nfc
+		}
+
+		b.Games[round] = []Matchup{{
+			Round:   round,
+			Home:    home,
+			Away:    away,
+			Neutral: true,
+		}}
+		return
+	}
+
+	matchups := make([]Matchup, 0)
+	for _, conf := range team.Conferences {
+		alive := b.Remaining(conf)
+
+		// The 1 seed sits out the Wild Card round
+		if round == WildCard {
+			alive = alive[1:]
+		}
+
+		// Pair the best remaining seed with the worst remaining seed, working inwards
+		for i, j := 0, len(alive)-1; i < j; i, j = i+1, j-1 {
+			matchups = append(matchups, Matchup{
+				Round:      round,
+				Conference: conf,
+				Home:       alive[i],
+				Away:       alive[j],
+			})
+		}
+	}
+
+	b.Games[round] = matchups
+}
+
+// afcDesignatedHome reports whether the AFC champion is the designated home team in the Super Bowl
+// The designation alternates, with the AFC champion as home team in odd-numbered Super Bowls
+func (b *Bracket) afcDesignatedHome() bool {
+	number := b.Season - firstSuperBowlSeason + 1
+	return number%2 == 1
+}
+
+// GamesFromRows converts scraped playoff rows (see scraper.ScrapePlayoffs) into games, in the order they were played
+func GamesFromRows(rows []scraper.ScrapedRow) []game.Game {
+	out := make([]game.Game, 0, len(rows))
+	for _, row := range rows {
+		// Skip games that have not been played yet
+		if !row.Played() {
+			continue
+		}
+		out = append(out, schedule.GameFromRow(row))
+	}
+
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].Time.Before(out[j].Time)
+	})
+
+	return out
+}
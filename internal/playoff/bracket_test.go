@@ -0,0 +1,100 @@
+package playoff
+
+import (
+	"nfl-app/internal/entry"
+	"nfl-app/internal/game"
+	"nfl-app/internal/team"
+	"testing"
+)
+
+func seededConference(teams []team.Team) []entry.Entry {
+	entries := make([]entry.Entry, len(teams))
+	for i, t := range teams {
+		entries[i] = entry.Entry{Team: t}
+		entries[i].Stats.Seed = i + 1
+	}
+	return entries
+}
+
+func win(winner, loser team.Team) game.Game {
+	return game.Game{Winner: winner.Name, Loser: loser.Name}
+}
+
+func TestBracketReseeding(t *testing.T) {
+	afc := []team.Team{team.BaltimoreRavens, team.BuffaloBills, team.KansasCityChiefs, team.HoustonTexans,
+		team.ClevelandBrowns, team.MiamiDolphins, team.PittsburghSteelers}
+	nfc := []team.Team{team.SanFrancisco49ers, team.DallasCowboys, team.DetroitLions, team.TampaBayBuccaneers,
+		team.PhiladelphiaEagles, team.LosAngelesRams, team.GreenBayPackers}
+
+	b, err := NewBracket(2023, map[string][]entry.Entry{
+		team.AFC: seededConference(afc),
+		team.NFC: seededConference(nfc),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := len(b.Matchups(WildCard)); got != 6 {
+		t.Fatalf("expected 6 Wild Card games, got %d", got)
+	}
+
+	// The 7 seed advances in the NFC, so it must visit the 1 seed
+	err = b.AddResults([]game.Game{
+		win(team.HoustonTexans, team.ClevelandBrowns),
+		win(team.KansasCityChiefs, team.MiamiDolphins),
+		win(team.BuffaloBills, team.PittsburghSteelers),
+		win(team.GreenBayPackers, team.DallasCowboys),
+		win(team.DetroitLions, team.LosAngelesRams),
+		win(team.TampaBayBuccaneers, team.PhiladelphiaEagles),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if b.Round() != Divisional {
+		t.Fatalf("expected %s round, got %s", Divisional, b.Round())
+	}
+	home, _, err := b.HomeTeam(team.GreenBayPackers.Name, team.SanFrancisco49ers.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if home != team.SanFrancisco49ers.Name {
+		t.Errorf("expected %s to host, got %s", team.SanFrancisco49ers.Name, home)
+	}
+	home, _, err = b.HomeTeam(team.HoustonTexans.Name, team.BaltimoreRavens.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if home != team.BaltimoreRavens.Name {
+		t.Errorf("expected %s to host, got %s", team.BaltimoreRavens.Name, home)
+	}
+
+	err = b.AddResults([]game.Game{
+		win(team.BaltimoreRavens, team.HoustonTexans),
+		win(team.KansasCityChiefs, team.BuffaloBills),
+		win(team.SanFrancisco49ers, team.GreenBayPackers),
+		win(team.DetroitLions, team.TampaBayBuccaneers),
+		win(team.KansasCityChiefs, team.BaltimoreRavens),
+		win(team.SanFrancisco49ers, team.DetroitLions),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sb, ok := b.SuperBowlMatchup()
+	if !ok {
+		t.Fatal("expected a Super Bowl matchup")
+	}
+	// Super Bowl LVIII, the NFC champion was the designated home team
+	if sb.Home.Team != team.SanFrancisco49ers || sb.Away.Team != team.KansasCityChiefs || !sb.Neutral {
+		t.Errorf("unexpected Super Bowl pairing: %s vs %s", sb.Home.Team.Name, sb.Away.Team.Name)
+	}
+
+	if err := b.AddResult(win(team.KansasCityChiefs, team.SanFrancisco49ers)); err != nil {
+		t.Fatal(err)
+	}
+	champ, ok := b.Champion()
+	if !ok || champ.Team != team.KansasCityChiefs {
+		t.Errorf("expected %s to be champion", team.KansasCityChiefs.Name)
+	}
+}
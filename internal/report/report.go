@@ -0,0 +1,222 @@
+package report
+
+import (
+	_ "embed"
+	"fmt"
+	"html/template"
+	"io"
+	"nfl-app/internal/entry"
+	"nfl-app/internal/entrysort"
+	"nfl-app/internal/playoff"
+	"nfl-app/internal/schedule"
+	"nfl-app/internal/standings"
+	"nfl-app/internal/team"
+	"strings"
+	"time"
+)
+
+//go:embed report.html.tmpl
+var pageTemplate string
+
+var tmpl = template.Must(template.New("report").Parse(pageTemplate))
+
+// Page is everything shown in the report
+type Page struct {
+	Season    int
+	Generated string
+
+	// Weeks holds the standings after each week, the last one being the latest
+	Weeks []Week
+}
+
+// Latest returns the number of the most recent week in the report
+func (p Page) Latest() int {
+	if len(p.Weeks) == 0 {
+		return 0
+	}
+	return p.Weeks[len(p.Weeks)-1].Number
+}
+
+// Week is the standings, seeding and playoff picture after a single week
+type Week struct {
+	Number      int
+	Divisions   []Division
+	Conferences []Conference
+}
+
+type Division struct {
+	Name string
+	Rows []Row
+}
+
+// Conference holds the seeds, the playoff picture and the tiebreakers used to seed it
+type Conference struct {
+	Name string
+
+	// Seeds holds the seven playoff teams, and Hunt the next three teams outside the playoffs
+	Seeds []Row
+	Hunt  []Row
+
+	Bye       Row
+	WildCards []Matchup
+
+	// Tiebreakers holds the steps that decided a position, Trace the whole seeding trace (latest week only)
+	Tiebreakers []string
+	Trace       string
+}
+
+type Matchup struct {
+	Home Row
+	Away Row
+}
+
+// Row is a single team in a table
+type Row struct {
+	Team         string
+	Abbreviation string
+	Color        string
+	Record       string
+	Pct          string
+	PointsFor    int
+	Against      int
+	Differential int
+	Division     string
+	Conference   string
+	Streak       string
+	Seed         int
+	Clincher     string
+}
+
+// Render writes a self-contained HTML report of the season, with a slider to step through the standings week by week
+func Render(w io.Writer, season int, sched schedule.Schedule) error {
+	page, err := Build(season, sched)
+	if err != nil {
+		return err
+	}
+	return tmpl.Execute(w, page)
+}
+
+// Build computes the standings after every week played so far
+func Build(season int, sched schedule.Schedule) (Page, error) {
+	page := Page{
+		Season:    season,
+		Generated: time.Now().Format("Mon Jan 2 2006 15:04"),
+	}
+
+	last := sched.LastPlayedWeek()
+	if last == 0 {
+		return Page{}, fmt.Errorf("no games have been played in the %d season", season)
+	}
+
+	for week := 1; week <= last; week++ {
+		w, err := buildWeek(sched, week)
+		if err != nil {
+			return Page{}, fmt.Errorf("week %d: %w", week, err)
+		}
+		page.Weeks = append(page.Weeks, w)
+	}
+
+	// Full traces run to thousands of lines a week, so only keep the latest one to keep the file small
+	for _, w := range page.Weeks[:len(page.Weeks)-1] {
+		for i := range w.Conferences {
+			w.Conferences[i].Trace = ""
+		}
+	}
+
+	return page, nil
+}
+
+func buildWeek(sched schedule.Schedule, number int) (Week, error) {
+	st, traces, err := standings.ComputeTrace(sched.Through(number))
+	if err != nil {
+		return Week{}, err
+	}
+
+	week := Week{Number: number}
+	for _, div := range team.Divisions {
+		d := Division{Name: div}
+		for _, e := range st.Division(div) {
+			d.Rows = append(d.Rows, newRow(e))
+		}
+		week.Divisions = append(week.Divisions, d)
+	}
+
+	for _, conf := range team.Conferences {
+		week.Conferences = append(week.Conferences, buildConference(st, conf, traces[conf]))
+	}
+
+	return week, nil
+}
+
+// huntSize is the number of teams outside the playoff spots shown as still in the hunt
+const huntSize = 3
+
+// buildConference lays out the conference's playoff picture, with the trace of the seeding that placed it
+func buildConference(st standings.Standings, conf string, trace *entrysort.Trace) Conference {
+	c := Conference{Name: conf}
+
+	seeded := st.Conference(conf)
+	for _, e := range seeded {
+		switch {
+		case e.Stats.Seed <= playoff.SeedsPerConference:
+			c.Seeds = append(c.Seeds, newRow(e))
+		case e.Stats.Seed <= playoff.SeedsPerConference+huntSize:
+			c.Hunt = append(c.Hunt, newRow(e))
+		}
+	}
+
+	// The 1 seed has a bye, and the other seeds pair off from the outside in: 2 v 7, 3 v 6 and 4 v 5
+	if len(c.Seeds) == playoff.SeedsPerConference {
+		c.Bye = c.Seeds[0]
+		for i := 1; i <= 3; i++ {
+			c.WildCards = append(c.WildCards, Matchup{Home: c.Seeds[i], Away: c.Seeds[playoff.SeedsPerConference-i]})
+		}
+	}
+
+	for _, step := range trace.Steps {
+		if step.Separated {
+			c.Tiebreakers = append(c.Tiebreakers, fmt.Sprintf("%s between %s: %s",
+				step.Tiebreaker, strings.Join(step.Teams, ", "), step.Outcome))
+		}
+	}
+	c.Trace = trace.String()
+
+	return c
+}
+
+func newRow(e entry.Entry) Row {
+	return Row{
+		Team:         e.Team.Name,
+		Abbreviation: e.Team.Abbreviation,
+		Color:        e.Team.Color,
+		Record:       record(e.Stats.Record.Wins(), e.Stats.Record.Losses(), e.Stats.Record.Ties()),
+		Pct:          strings.TrimPrefix(fmt.Sprintf("%.3f", e.Stats.Record.WinPercentage()), "0"),
+		PointsFor:    e.Stats.Points.For,
+		Against:      e.Stats.Points.Against,
+		Differential: e.Stats.Points.Differential(),
+		Division: record(e.Stats.DivisionRecord.Wins(), e.Stats.DivisionRecord.Losses(),
+			e.Stats.DivisionRecord.Ties()),
+		Conference: record(e.Stats.ConferenceRecord.Wins(), e.Stats.ConferenceRecord.Losses(),
+			e.Stats.ConferenceRecord.Ties()),
+		Streak:   streak(e.Stats.Streak),
+		Seed:     e.Stats.Seed,
+		Clincher: e.Stats.Clincher,
+	}
+}
+
+func record(wins, losses, ties int) string {
+	if ties > 0 {
+		return fmt.Sprintf("%d-%d-%d", wins, losses, ties)
+	}
+	return fmt.Sprintf("%d-%d", wins, losses)
+}
+
+func streak(s int) string {
+	switch {
+	case s > 0:
+		return fmt.Sprintf("W%d", s)
+	case s < 0:
+		return fmt.Sprintf("L%d", -s)
+	}
+	return ""
+}
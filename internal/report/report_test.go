@@ -0,0 +1,121 @@
+package report
+
+import (
+	"bytes"
+	"fmt"
+	"nfl-app/internal/game"
+	"nfl-app/internal/playoff"
+	"nfl-app/internal/schedule"
+	"nfl-app/internal/team"
+	"strings"
+	"testing"
+)
+
+// season returns a schedule with every team playing in each of the given weeks, the first team listed winning
+func season(weeks int) schedule.Schedule {
+	sched := schedule.NewSchedule()
+	n := len(team.NFLTeams)
+	for week := 1; week <= weeks; week++ {
+		for i := 0; i < n/2; i++ {
+			home, away := team.NFLTeams[i].Name, team.NFLTeams[(i+week*3)%(n/2)+n/2].Name
+			sched.AddGame(week, game.Game{Home: home, Away: away, Winner: home, Loser: away, PtsWin: 20 + i, PtsLose: 10})
+		}
+	}
+	return sched
+}
+
+func TestBuild(t *testing.T) {
+	page, err := Build(2024, season(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.Weeks) != 2 || page.Latest() != 2 {
+		t.Fatalf("expected weeks 1 and 2, got %d weeks up to %d", len(page.Weeks), page.Latest())
+	}
+
+	for _, w := range page.Weeks {
+		if len(w.Divisions) != len(team.Divisions) || len(w.Conferences) != len(team.Conferences) {
+			t.Fatalf("week %d: expected every division and conference, got %d and %d", w.Number, len(w.Divisions), len(w.Conferences))
+		}
+		for _, c := range w.Conferences {
+			if len(c.Seeds) != playoff.SeedsPerConference || len(c.Hunt) != huntSize {
+				t.Errorf("week %d, %s: expected %d seeds and %d in the hunt, got %d and %d",
+					w.Number, c.Name, playoff.SeedsPerConference, huntSize, len(c.Seeds), len(c.Hunt))
+			}
+			for i, row := range c.Seeds {
+				if row.Seed != i+1 {
+					t.Errorf("week %d, %s: expected seed %d, got %d for %s", w.Number, c.Name, i+1, row.Seed, row.Team)
+				}
+			}
+			if c.Bye != c.Seeds[0] || len(c.WildCards) != 3 || c.WildCards[0].Home != c.Seeds[1] || c.WildCards[0].Away != c.Seeds[6] {
+				t.Errorf("week %d, %s: expected the 1 seed to have the bye and 2 to host 7, got %+v", w.Number, c.Name, c)
+			}
+			if len(c.Tiebreakers) == 0 {
+				t.Errorf("week %d, %s: expected the ties in the standings to need tiebreakers", w.Number, c.Name)
+			}
+
+			// Only the latest week keeps its full trace
+			if latest := w.Number == page.Latest(); (c.Trace != "") != latest {
+				t.Errorf("week %d, %s: expected a trace only for the latest week, got %d bytes", w.Number, c.Name, len(c.Trace))
+			}
+		}
+	}
+
+	if _, err := Build(2024, schedule.NewSchedule()); err == nil {
+		t.Errorf("expected an error for a season with no games played")
+	}
+}
+
+func TestRender(t *testing.T) {
+	var b bytes.Buffer
+	if err := Render(&b, 2024, season(2)); err != nil {
+		t.Fatal(err)
+	}
+	html := b.String()
+
+	for _, want := range []string{
+		"<title>2024 NFL standings</title>",
+		`max="2" value="2"`,
+		`data-week="1" hidden>`,
+		`data-week="2">`,
+		"Standings after week 1",
+		"Playoff picture after week 2",
+		"z clinched first round bye",
+	} {
+		if !strings.Contains(html, want) {
+			t.Errorf("expected the report to contain %q", want)
+		}
+	}
+
+	counts := map[string]int{
+		// Two conferences a week
+		"has the bye":          4,
+		"<h4>Tiebreakers</h4>": 4,
+		// The full trace is only kept for the latest week
+		"Full seeding trace": 2,
+	}
+	for s, want := range counts {
+		if got := strings.Count(html, s); got != want {
+			t.Errorf("expected %q %d times, got %d", s, want, got)
+		}
+	}
+
+	// A team seeded in both weeks has a row in its division's table and its conference's seeds each week
+	page, err := Build(2024, season(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	seeded := make(map[string]int)
+	for _, w := range page.Weeks {
+		for _, c := range w.Conferences {
+			for _, row := range c.Seeds {
+				seeded[row.Team]++
+			}
+		}
+	}
+	for name, weeks := range seeded {
+		if got := strings.Count(html, fmt.Sprintf(">%s</td>", name)); weeks == 2 && got != 4 {
+			t.Errorf("expected 4 rows for %s, got %d", name, got)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+// Package schedgen generates full season schedules under the league's scheduling formula
+package schedgen
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"nfl-app/internal/game"
+	"nfl-app/internal/schedule"
+	"nfl-app/internal/team"
+	"slices"
+)
+
+const (
+	// Weeks is the number of weeks in a season, one of them a bye for every team
+	Weeks = 18
+
+	// FirstByeWeek and LastByeWeek bound the weeks teams can have their bye in
+	FirstByeWeek = 5
+	LastByeWeek  = 14
+
+	// attempts is how many times to try filling the weeks before giving up, each with a fresh draw of byes
+	attempts = 100
+
+	// searchLimit bounds the search for a single week's games, beyond which the attempt starts over
+	searchLimit = 20000
+)
+
+// Generate returns a schedule for the season with every game still to be played
+// places holds each team's finish in its division the season before, see team.SeasonMatchups. The seed decides
+// the byes and which week each game is played in, so the same seed always gives the same schedule
+//
+// Every team gets one bye between FirstByeWeek and LastByeWeek, and the last week is all division games
+func Generate(season int, places map[string]int, seed uint64) (schedule.Schedule, error) {
+	matchups, err := team.SeasonMatchups(season, places)
+	if err != nil {
+		return schedule.Schedule{}, err
+	}
+
+	rng := rand.New(rand.NewPCG(seed, uint64(season)))
+	for range attempts {
+		weeks, ok := fill(matchups, rng)
+		if !ok {
+			continue
+		}
+
+		sched := schedule.NewSchedule()
+		for i, week := range weeks {
+			for _, m := range week {
+				sched.AddRemaining(i+1, game.Game{Home: m.Home.Name, Away: m.Away.Name})
+			}
+		}
+		return sched, nil
+	}
+	return schedule.Schedule{}, fmt.Errorf("could not fit the %d season into %d weeks", season, Weeks)
+}
+
+// fill spreads the matchups over the weeks, reporting false when it runs into a week it cannot complete
+func fill(matchups []team.Matchup, rng *rand.Rand) ([][]team.Matchup, bool) {
+	left := slices.Clone(matchups)
+	weeks := make([][]team.Matchup, Weeks)
+	byes := drawByes(rng)
+
+	// The last week first, while every division game is still available
+	var ok bool
+	weeks[Weeks-1], ok = pickWeek(&left, team.NFLTeams, rng, func(m team.Matchup) bool {
+		return m.Home.Division == m.Away.Division
+	})
+	if !ok {
+		return nil, false
+	}
+
+	for week := 1; week < Weeks; week++ {
+		playing := make([]team.Team, 0, len(team.NFLTeams))
+		for _, t := range team.NFLTeams {
+			if byes[t.Name] != week {
+				playing = append(playing, t)
+			}
+		}
+		weeks[week-1], ok = pickWeek(&left, playing, rng, func(team.Matchup) bool { return true })
+		if !ok {
+			return nil, false
+		}
+	}
+	return weeks, true
+}
+
+// drawByes gives every team a bye week. Each bye week gets two or four teams, so the rest can still pair up
+func drawByes(rng *rand.Rand) map[string]int {
+	count := make([]int, LastByeWeek-FirstByeWeek+1)
+	for i := range count {
+		count[i] = 2
+	}
+	for extra := len(team.NFLTeams) - 2*len(count); extra > 0; extra -= 2 {
+		i := rng.IntN(len(count))
+		for count[i] == 4 {
+			i = rng.IntN(len(count))
+		}
+		count[i] = 4
+	}
+
+	order := rng.Perm(len(team.NFLTeams))
+	byes := make(map[string]int)
+	at := 0
+	for i, n := range count {
+		for range n {
+			byes[team.NFLTeams[order[at]].Name] = FirstByeWeek + i
+			at++
+		}
+	}
+	return byes
+}
+
+// pickWeek chooses a game for every playing team from the matchups left, removing them from left
+// It searches the team with the fewest options first, backtracking when a team is left without an opponent
+func pickWeek(left *[]team.Matchup, playing []team.Team, rng *rand.Rand, allowed func(team.Matchup) bool) ([]team.Matchup, bool) {
+	free := make(map[string]bool)
+	for _, t := range playing {
+		free[t.Name] = true
+	}
+
+	// Shuffle so the search does not always find the same week
+	order := rng.Perm(len(*left))
+	candidates := make([]int, 0, len(order))
+	for _, i := range order {
+		m := (*left)[i]
+		if free[m.Home.Name] && free[m.Away.Name] && allowed(m) {
+			candidates = append(candidates, i)
+		}
+	}
+
+	picked := make([]int, 0, len(playing)/2)
+	steps := 0
+	var search func() bool
+	search = func() bool {
+		steps++
+		if steps > searchLimit {
+			return false
+		}
+
+		// The free team with the fewest games to choose from
+		best, options := "", []int(nil)
+		for _, t := range playing {
+			if !free[t.Name] {
+				continue
+			}
+			opts := make([]int, 0)
+			for _, i := range candidates {
+				m := (*left)[i]
+				if (m.Home.Name == t.Name || m.Away.Name == t.Name) && free[m.Home.Name] && free[m.Away.Name] {
+					opts = append(opts, i)
+				}
+			}
+			if best == "" || len(opts) < len(options) {
+				best, options = t.Name, opts
+			}
+		}
+		if best == "" {
+			return true
+		}
+
+		for _, i := range options {
+			m := (*left)[i]
+			free[m.Home.Name], free[m.Away.Name] = false, false
+			picked = append(picked, i)
+			if search() {
+				return true
+			}
+			picked = picked[:len(picked)-1]
+			free[m.Home.Name], free[m.Away.Name] = true, true
+		}
+		return false
+	}
+	if !search() {
+		return nil, false
+	}
+
+	week := make([]team.Matchup, len(picked))
+	for j, i := range picked {
+		week[j] = (*left)[i]
+	}
+	slices.Sort(picked)
+	for j := len(picked) - 1; j >= 0; j-- {
+		*left = slices.Delete(*left, picked[j], picked[j]+1)
+	}
+	return week, true
+}
@@ -0,0 +1,77 @@
+package schedgen
+
+import (
+	"nfl-app/internal/team"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	for _, season := range []int{2024, 2025, 2026} {
+		sched, err := Generate(season, nil, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		games := make(map[string]int)
+		home := make(map[string]int)
+		byes := make(map[string]int)
+		pairs := make(map[[2]string]int)
+		for _, week := range sched.Weeks {
+			playing := make(map[string]bool)
+			for _, g := range week.Remaining {
+				if playing[g.Home] || playing[g.Away] {
+					t.Fatalf("%d week %d: a team plays twice in %s at %s", season, week.Number, g.Away, g.Home)
+				}
+				playing[g.Home], playing[g.Away] = true, true
+				games[g.Home]++
+				games[g.Away]++
+				home[g.Home]++
+				pairs[[2]string{g.Home, g.Away}]++
+
+				if week.Number == Weeks && !team.SameDivision(g.Home, g.Away) {
+					t.Errorf("%d: expected only division games in the last week, got %s at %s", season, g.Away, g.Home)
+				}
+			}
+			for _, tm := range team.NFLTeams {
+				if !playing[tm.Name] {
+					if week.Number < FirstByeWeek || week.Number > LastByeWeek {
+						t.Errorf("%d: %s has a bye in week %d", season, tm.Name, week.Number)
+					}
+					byes[tm.Name]++
+				}
+			}
+		}
+
+		for _, tm := range team.NFLTeams {
+			if games[tm.Name] != 17 || byes[tm.Name] != 1 {
+				t.Errorf("%d: %s has %d games and %d byes", season, tm.Name, games[tm.Name], byes[tm.Name])
+			}
+			if home[tm.Name] < 8 || home[tm.Name] > 9 {
+				t.Errorf("%d: %s has %d home games", season, tm.Name, home[tm.Name])
+			}
+		}
+		for pair, n := range pairs {
+			if n != 1 {
+				t.Errorf("%d: %s host %s %d times", season, pair[0], pair[1], n)
+			}
+		}
+	}
+}
+
+func TestGenerateIsReproducible(t *testing.T) {
+	a, err := Generate(2025, nil, 42)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := Generate(2025, nil, 42)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range a.Weeks {
+		for j, g := range a.Weeks[i].Remaining {
+			if b.Weeks[i].Remaining[j] != g {
+				t.Fatalf("week %d differs between runs with the same seed", i+1)
+			}
+		}
+	}
+}
@@ -178,16 +178,21 @@ func StrengthOf(team string, entries []entry.Entry, ts map[string]Schedule, attr
 		panic("unknown attribute")
 	}
 
-	combinedOpponentRecord := stats.NewRecord(0, 0, 0)
-
-	// Create map of team names to Entry for convenience
-	teamMap := make(map[string]entry.Entry)
-	for _, entry := range entries {
-		teamMap[entry.Team.Name] = entry
+	sov, sos := Strengths(team, entry.ByTeam(entries), ts)
+	if attribute == strengthOfVictory {
+		return sov
 	}
+	return sos
+}
 
-	schedule := ts[team]
-	for _, week := range schedule.Weeks {
+// Strengths returns the team's strength of victory and strength of schedule, looking up opponents in byTeam
+// Building byTeam once and reusing it for every team keeps computing these for the whole league linear
+func Strengths(team string, byTeam map[string]entry.Entry, ts map[string]Schedule) (float64, float64) {
+	victory := stats.NewRecord(0, 0, 0)
+	schedule := stats.NewRecord(0, 0, 0)
+
+	teamSchedule := ts[team]
+	for _, week := range teamSchedule.Weeks {
 		if len(week.Games) == 0 {
 			continue
 		}
@@ -201,31 +206,43 @@ func StrengthOf(team string, entries []entry.Entry, ts map[string]Schedule, attr
 			opp = game.Home
 		}
 
-		// For victory, only consider games that were won
-		if attribute == strengthOfVictory && game.Winner != team {
-			continue
-		}
-
-		// For schedule, consider all games
-		oppEntry := teamMap[opp]
+		oppEntry := byTeam[opp]
 		oppRecord := stats.NewRecord(
 			oppEntry.Stats.Record.Wins(),
 			oppEntry.Stats.Record.Losses(),
 			oppEntry.Stats.Record.Ties(),
 		)
 
-		combinedOpponentRecord.Add(oppRecord)
+		// For schedule, consider all games. For victory, only consider games that were won
+		schedule.Add(oppRecord)
+		if game.Winner == team {
+			victory.Add(oppRecord)
+		}
 	}
 
-	return combinedOpponentRecord.WinPercentage()
+	return victory.WinPercentage(), schedule.WinPercentage()
+}
+
+// RemainingStrength returns the combined win percentage of the opponents the team has yet to play, by their
+// current records, looking up opponents in byTeam. It is 0 when the team has no games left
+func RemainingStrength(team string, byTeam map[string]entry.Entry, ts map[string]Schedule) float64 {
+	remaining := stats.NewRecord(0, 0, 0)
+	for _, week := range ts[team].Weeks {
+		for _, game := range week.Remaining {
+			record := byTeam[game.Opponent(team)].Stats.Record
+			remaining.Add(&record)
+		}
+	}
+	return remaining.WinPercentage()
 }
 
 // Ranking returns a map of team names to their rank amongst the given entries based on the given stat
-// Tied teams will shared the rank
+// Tied teams will shared the rank. The given slice is not modified
 func Ranking(entries []entry.Entry, stat string) map[string]int {
 	if stat != pointsFor && stat != pointsAgainst {
 		panic("unsupported stat")
 	}
+	entries = slices.Clone(entries)
 
 	var sortFunc func(i, j int) bool
 	switch stat {
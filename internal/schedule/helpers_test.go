@@ -0,0 +1,26 @@
+package schedule
+
+import (
+	"nfl-app/internal/entry"
+	"nfl-app/internal/team"
+	"slices"
+	"testing"
+)
+
+func TestRankingLeavesEntriesAlone(t *testing.T) {
+	entries := make([]entry.Entry, 0)
+	for i, tm := range team.NFLTeams[:4] {
+		e := entry.NewEntry(tm.Name)
+		e.Stats.Points.For = 100 + 10*i
+		entries = append(entries, *e)
+	}
+	original := slices.Clone(entries)
+
+	ranks := Ranking(entries, pointsFor)
+	if !slices.Equal(entries, original) {
+		t.Errorf("expected the entries to be left in their order, got %s", entry.Teams(entries))
+	}
+	if ranks[team.NFLTeams[3].Name] != 1 || ranks[team.NFLTeams[0].Name] != 4 {
+		t.Errorf("expected the most points to rank first, got %v", ranks)
+	}
+}
@@ -0,0 +1,66 @@
+package schedule
+
+import (
+	"encoding/json"
+	"fmt"
+	"nfl-app/internal/game"
+)
+
+// scheduleJSON is the JSON shape of a Schedule: {"weeks": [...]}
+type scheduleJSON struct {
+	Weeks []weekJSON `json:"weeks"`
+}
+
+// weekJSON is the JSON shape of a Week: {"number": 1, "games": [...], "remaining": [...]}
+// Both lists are always present, and empty when the week has no games of that kind
+type weekJSON struct {
+	Number    int         `json:"number"`
+	Games     []game.Game `json:"games"`
+	Remaining []game.Game `json:"remaining"`
+}
+
+// MarshalJSON encodes every week of the schedule, including the games not played yet
+func (s Schedule) MarshalJSON() ([]byte, error) {
+	v := scheduleJSON{Weeks: make([]weekJSON, len(s.Weeks))}
+	for i, w := range s.Weeks {
+		v.Weeks[i] = weekJSON{
+			Number:    w.Number,
+			Games:     nonNil(w.Games),
+			Remaining: nonNil(w.Remaining),
+		}
+	}
+	return json.Marshal(v)
+}
+
+// UnmarshalJSON decodes a schedule encoded by MarshalJSON. Weeks keep their position in the list,
+// and a week numbered anything other than its position (or zero, for a week with no games) is rejected
+func (s *Schedule) UnmarshalJSON(data []byte) error {
+	var v scheduleJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	weeks := make([]Week, len(v.Weeks))
+	for i, w := range v.Weeks {
+		if w.Number != 0 && w.Number != i+1 {
+			return fmt.Errorf("week %d is at position %d", w.Number, i+1)
+		}
+		weeks[i] = Week{Number: w.Number}
+		if len(w.Games) > 0 {
+			weeks[i].Games = w.Games
+		}
+		if len(w.Remaining) > 0 {
+			weeks[i].Remaining = w.Remaining
+		}
+	}
+
+	*s = Schedule{Weeks: weeks}
+	return nil
+}
+
+func nonNil(games []game.Game) []game.Game {
+	if games == nil {
+		return []game.Game{}
+	}
+	return games
+}
@@ -0,0 +1,76 @@
+package schedule
+
+import (
+	"bytes"
+	"encoding/json"
+	"nfl-app/internal/entry"
+	"nfl-app/internal/scraper"
+	"testing"
+)
+
+func TestJSONRoundTrip(t *testing.T) {
+	sched := CreateSchedule([]scraper.ScrapedRow{
+		{Week: "1", DayOfWeek: "Sun", Date: "2024-09-08", Gametime: "1:00PM", Winner: "Buffalo Bills",
+			Loser: "Miami Dolphins", PtsWin: "24", PtsLose: "17", YardsWin: "350", YardsLose: "290", ToWin: "1", ToLose: "2"},
+		{Week: "2", Winner: "New York Jets", GameLocation: "@", Loser: "Buffalo Bills", PtsWin: "20", PtsLose: "20"},
+		{Week: "3", Winner: "Miami Dolphins", GameLocation: "@", Loser: "New York Jets"},
+	})
+
+	data, err := json.Marshal(sched)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded Schedule
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	again, err := json.Marshal(decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, again) {
+		t.Fatalf("schedule changed in a round trip:\n%s\n%s", data, again)
+	}
+	if got := len(decoded.RemainingGames()); got != 1 {
+		t.Errorf("expected 1 remaining game, got %d", got)
+	}
+
+	// Entries computed from the decoded schedule must match, including the records
+	entries := CreateEntries(sched)
+	data, err = json.Marshal(entries)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(data, []byte(`"record":{"wins":1,"losses":0,"ties":1}`)) {
+		t.Errorf("expected the Bills' 1-0-1 record in %s", data)
+	}
+
+	var decodedEntries []entry.Entry
+	if err := json.Unmarshal(data, &decodedEntries); err != nil {
+		t.Fatal(err)
+	}
+	for _, got := range [][]entry.Entry{decodedEntries, CreateEntries(decoded)} {
+		again, err = json.Marshal(got)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(data, again) {
+			t.Fatalf("entries changed in a round trip:\n%s\n%s", data, again)
+		}
+	}
+}
+
+func TestJSONRejectsBadGames(t *testing.T) {
+	bad := []string{
+		`{"weeks": [{"number": 1, "games": [{"home": "Buffalo Bills", "away": "Miami Dolphins", "winner": "New York Jets", "loser": "Miami Dolphins"}]}]}`,
+		`{"weeks": [{"number": 1, "games": [{"home": "Buffalo Bills", "away": "Miami Dolphins", "winner": "Buffalo Bills"}]}]}`,
+		`{"weeks": [{"number": 2}]}`,
+	}
+	for _, b := range bad {
+		var s Schedule
+		if err := json.Unmarshal([]byte(b), &s); err == nil {
+			t.Errorf("expected an error decoding %s", b)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package schedule
+
+import (
+	"math"
+	"nfl-app/internal/entry"
+	"nfl-app/internal/stats"
+	"slices"
+)
+
+const (
+	// srsIterations and srsTolerance bound the solve for the simple ratings. The tolerance is on the equations,
+	// in points over a team's season
+	srsIterations = 1000
+	srsTolerance  = 1e-9
+)
+
+// RateEntries sets the SRS, expected wins and luck of every entry
+func RateEntries(entries []entry.Entry, ts map[string]Schedule) {
+	srs := SimpleRatings(entries, ts, stats.PointMargin)
+	for i := range entries {
+		s := &entries[i].Stats
+		s.SRS = srs[entries[i].Team.Name]
+
+		games := float64(s.Record.GamesPlayed())
+		s.ExpectedWins = s.Points.PythagoreanWinPercentage() * games
+		s.Luck = float64(s.Record.Wins()) + float64(s.Record.Ties())/2 - s.ExpectedWins
+	}
+}
+
+// SimpleRatings returns the simple rating system rating of every entry, keyed by team name
+// A team's rating is its average margin plus the average rating of the opponents it has played. Multiplied out by
+// games played, that is one linear equation per team, solved by conjugate gradients until the equations are met to
+// within srsTolerance. Ratings are centered on 0 among teams linked by the games played, so an average team rates 0
+//
+// margin gives a team's average margin per game, stats.PointMargin for the usual SRS. stats.YardMargin and
+// stats.TurnoverMargin rate teams on yards and turnovers instead, in yards and turnovers per game
+func SimpleRatings(entries []entry.Entry, ts map[string]Schedule, margin stats.Column) map[string]float64 {
+	// Only teams that have played are rated, the rest staying at 0. They are indexed by name, so rounding
+	// does not depend on the order of the entries
+	byName := make(map[string]*entry.Entry)
+	names := make([]string, 0, len(entries))
+	for i := range entries {
+		if entries[i].Stats.Record.GamesPlayed() == 0 {
+			continue
+		}
+		byName[entries[i].Team.Name] = &entries[i]
+		names = append(names, entries[i].Team.Name)
+	}
+	slices.Sort(names)
+	index := make(map[string]int, len(names))
+	for i, name := range names {
+		index[name] = i
+	}
+
+	// Each team's equation is games * rating - the sum of its opponents' ratings = games * margin. An opponent
+	// that is not rated counts as 0
+	opponents := make([][]int, len(names))
+	games := make([]float64, len(names))
+	total := make([]float64, len(names))
+	for i, name := range names {
+		for _, week := range ts[name].Weeks {
+			for _, g := range week.Games {
+				games[i]++
+				if j, ok := index[g.Opponent(name)]; ok {
+					opponents[i] = append(opponents[i], j)
+				}
+			}
+		}
+		total[i] = games[i] * margin.Value(&byName[name].Stats)
+	}
+	apply := func(x, out []float64) {
+		for i := range x {
+			out[i] = games[i] * x[i]
+			for _, j := range opponents[i] {
+				out[i] -= x[j]
+			}
+		}
+	}
+
+	// Starting from 0 keeps every step within the teams' margins, so each group of teams linked by games ends
+	// up centered on 0 even though the equations alone only fix the ratings up to a constant per group
+	ratings := make([]float64, len(names))
+	residual := slices.Clone(total)
+	direction := slices.Clone(total)
+	step := make([]float64, len(names))
+	size := dot(residual, residual)
+	for range srsIterations {
+		if math.Sqrt(size) < srsTolerance {
+			break
+		}
+		apply(direction, step)
+		curvature := dot(direction, step)
+		if curvature <= 0 {
+			break
+		}
+
+		alpha := size / curvature
+		for i := range ratings {
+			ratings[i] += alpha * direction[i]
+			residual[i] -= alpha * step[i]
+		}
+		next := dot(residual, residual)
+		for i := range direction {
+			direction[i] = residual[i] + next/size*direction[i]
+		}
+		size = next
+	}
+
+	out := make(map[string]float64, len(names))
+	for i, name := range names {
+		out[name] = ratings[i]
+	}
+	return out
+}
+
+func dot(a, b []float64) float64 {
+	sum := 0.0
+	for i := range a {
+		sum += a[i] * b[i]
+	}
+	return sum
+}
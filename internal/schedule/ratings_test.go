@@ -0,0 +1,88 @@
+package schedule
+
+import (
+	"math"
+	"nfl-app/internal/entry"
+	"nfl-app/internal/game"
+	"nfl-app/internal/stats"
+	"nfl-app/internal/team"
+	"testing"
+)
+
+func TestRateEntries(t *testing.T) {
+	a, b, c := team.NFLTeams[0].Name, team.NFLTeams[1].Name, team.NFLTeams[2].Name
+	sched := NewSchedule()
+	sched.AddGame(1, game.Game{Winner: a, Loser: b, Home: a, Away: b, PtsWin: 24, PtsLose: 10})
+	sched.AddGame(2, game.Game{Winner: b, Loser: c, Home: c, Away: b, PtsWin: 17, PtsLose: 10})
+	sched.AddGame(3, game.Game{Winner: a, Loser: c, Home: c, Away: a, PtsWin: 31, PtsLose: 10})
+
+	entries := CreateEntries(sched)
+	byName := make(map[string]int)
+	for i, e := range entries {
+		byName[e.Team.Name] = i
+	}
+
+	// The margins are consistent, a by 14 over b and b by 7 over c, so the ratings keep those gaps around 0
+	want := map[string]float64{a: 35.0 / 3, b: 35.0/3 - 14, c: 35.0/3 - 21}
+	for name, rating := range want {
+		if got := entries[byName[name]].Stats.SRS; math.Abs(got-rating) > 1e-6 {
+			t.Errorf("expected %s to have an SRS of %.3f, got %.3f", name, rating, got)
+		}
+	}
+
+	s := entries[byName[a]].Stats
+	pct := math.Pow(55, 2.37) / (math.Pow(55, 2.37) + math.Pow(20, 2.37))
+	if math.Abs(s.ExpectedWins-2*pct) > 1e-9 {
+		t.Errorf("expected %.3f expected wins, got %.3f", 2*pct, s.ExpectedWins)
+	}
+	if math.Abs(s.Luck-(2-2*pct)) > 1e-9 {
+		t.Errorf("expected luck of %.3f, got %.3f", 2-2*pct, s.Luck)
+	}
+}
+
+func TestYardsAndTurnovers(t *testing.T) {
+	a, b := team.NFLTeams[0].Name, team.NFLTeams[1].Name
+	sched := NewSchedule()
+	sched.AddGame(1, game.Game{Winner: a, Loser: b, Home: b, Away: a, PtsWin: 24, PtsLose: 10,
+		YardsWin: 400, YardsLose: 250, ToWin: 1, ToLose: 3})
+	// In a tie the *Win fields are the home team's
+	sched.AddGame(2, game.Game{Home: a, Away: b, PtsWin: 17, PtsLose: 17, YardsWin: 300, YardsLose: 350, ToWin: 2, ToLose: 0})
+
+	entries := CreateEntries(sched)
+	byTeam := entry.ByTeam(entries)
+	sa, sb := byTeam[a].Stats, byTeam[b].Stats
+	if sa.Yards != (stats.Yards{Gained: 700, Allowed: 600}) || sb.Yards != (stats.Yards{Gained: 600, Allowed: 700}) {
+		t.Errorf("unexpected yards %+v and %+v", sa.Yards, sb.Yards)
+	}
+	if sa.Turnovers != (stats.Turnovers{Committed: 3, Forced: 3}) || sb.Turnovers != (stats.Turnovers{Committed: 3, Forced: 3}) {
+		t.Errorf("unexpected turnovers %+v and %+v", sa.Turnovers, sb.Turnovers)
+	}
+	if got := sa.PerGame(sa.Yards.Gained); got != 350 {
+		t.Errorf("expected 350 yards per game, got %.1f", got)
+	}
+
+	sorted := entry.SortByColumn(entries, stats.YardMargin)
+	if sorted[0].Team.Name != a {
+		t.Errorf("expected %s first by yard margin, got %s", a, entry.Teams(sorted))
+	}
+	yards := SimpleRatings(entries, sched.SplitToTeams(), stats.YardMargin)
+	if math.Abs(yards[a]-25) > 1e-6 || math.Abs(yards[b]+25) > 1e-6 {
+		t.Errorf("expected yard ratings of 25 and -25, got %v", yards)
+	}
+}
+
+func TestSimpleRatingsSeparateGroups(t *testing.T) {
+	a, b, c, d := team.NFLTeams[0].Name, team.NFLTeams[1].Name, team.NFLTeams[2].Name, team.NFLTeams[3].Name
+	sched := NewSchedule()
+	sched.AddGame(1, game.Game{Winner: a, Loser: b, Home: a, Away: b, PtsWin: 30, PtsLose: 10})
+	sched.AddGame(1, game.Game{Winner: c, Loser: d, Home: d, Away: c, PtsWin: 13, PtsLose: 10})
+
+	// Nothing links the two games, so each pair is centered on 0 on its own
+	ratings := SimpleRatings(CreateEntries(sched), sched.SplitToTeams(), stats.PointMargin)
+	want := map[string]float64{a: 10, b: -10, c: 1.5, d: -1.5}
+	for name, rating := range want {
+		if math.Abs(ratings[name]-rating) > 1e-6 {
+			t.Errorf("expected %s to rate %.1f, got %.3f", name, rating, ratings[name])
+		}
+	}
+}
@@ -7,7 +7,9 @@ import (
 	"nfl-app/internal/game"
 	"nfl-app/internal/scraper"
 	"nfl-app/internal/team"
+	"slices"
 	"strconv"
+	"strings"
 )
 
 // Schedule represents an NFL schedule for one or more teams
@@ -20,6 +22,10 @@ type Schedule struct {
 type Week struct {
 	Number int
 	Games  []game.Game
+
+	// Remaining holds the games scheduled for this week that have not been played yet
+	// Only Home, Away and Time are meaningful for these games
+	Remaining []game.Game
 }
 
 // NewSchedule creates a new empty schedule with 18 weeks
@@ -43,6 +49,90 @@ func (s *Schedule) AddGame(week int, game game.Game) {
 	s.Weeks[week-1].Games = append(s.Weeks[week-1].Games, game)
 }
 
+// AddRemaining adds a game that has not been played yet to the given week
+func (s *Schedule) AddRemaining(week int, game game.Game) {
+	s.Weeks[week-1].Remaining = append(s.Weeks[week-1].Remaining, game)
+}
+
+// Play records the result of a remaining game, moving it from the week's Remaining games to its Games
+// The remaining game is matched on home and away team
+func (s *Schedule) Play(week int, result game.Game) error {
+	if week < 1 || week > len(s.Weeks) {
+		return fmt.Errorf("week %d is outside of the schedule", week)
+	}
+
+	w := &s.Weeks[week-1]
+	for i, g := range w.Remaining {
+		if g.Home != result.Home || g.Away != result.Away {
+			continue
+		}
+
+		if result.Time.IsZero() {
+			result.Time = g.Time
+		}
+		w.Remaining = slices.Delete(slices.Clone(w.Remaining), i, i+1)
+		w.Games = append(slices.Clone(w.Games), result)
+		return nil
+	}
+
+	return fmt.Errorf("no remaining game %s@%s in week %d", result.Away, result.Home, week)
+}
+
+// ScheduledGame is a game that has not been played yet, along with the week it is scheduled for
+type ScheduledGame struct {
+	Week int
+	Game game.Game
+}
+
+// RemainingGames returns every game that has not been played yet, in week order
+func (s *Schedule) RemainingGames() []ScheduledGame {
+	out := make([]ScheduledGame, 0)
+	for i, week := range s.Weeks {
+		for _, g := range week.Remaining {
+			out = append(out, ScheduledGame{Week: i + 1, Game: g})
+		}
+	}
+	return out
+}
+
+// Clone returns a deep copy of the schedule, so it can be modified without affecting the original
+func (s *Schedule) Clone() Schedule {
+	weeks := make([]Week, len(s.Weeks))
+	for i, week := range s.Weeks {
+		weeks[i] = Week{
+			Number:    week.Number,
+			Games:     slices.Clone(week.Games),
+			Remaining: slices.Clone(week.Remaining),
+		}
+	}
+
+	return Schedule{Weeks: weeks}
+}
+
+// Through returns a copy of the schedule as it stood after the given week. Games played in later weeks
+// are turned back into remaining games, with their results cleared
+func (s *Schedule) Through(week int) Schedule {
+	out := s.Clone()
+	for i := max(week, 0); i < len(out.Weeks); i++ {
+		w := &out.Weeks[i]
+		for _, g := range w.Games {
+			w.Remaining = append(w.Remaining, game.Game{Time: g.Time, Home: g.Home, Away: g.Away})
+		}
+		w.Games = nil
+	}
+	return out
+}
+
+// LastPlayedWeek returns the number of the latest week with at least one result, or 0 if nothing has been played
+func (s *Schedule) LastPlayedWeek() int {
+	for i := len(s.Weeks) - 1; i >= 0; i-- {
+		if len(s.Weeks[i].Games) > 0 {
+			return i + 1
+		}
+	}
+	return 0
+}
+
 func CreateSchedule(rows []scraper.ScrapedRow) Schedule {
 	weeks := make([]Week, 18)
 
@@ -51,40 +141,13 @@ func CreateSchedule(rows []scraper.ScrapedRow) Schedule {
 		weekNum, _ := strconv.Atoi(row.Week) // TODO: handle error
 		week := &weeks[weekNum-1]
 
-		// Parse the time
-		t := scraper.ParseTime(row)
-
-		// Determine home/away. Winner is listed first, @ is used optionally
-		var home, away string
-		if row.GameLocation == "@" {
-			home = row.Loser
-			away = row.Winner
-		} else {
-			home = row.Winner
-			away = row.Loser
-		}
+		g := GameFromRow(row)
+		week.Number = weekNum // TODO: Don't need to do this every loop, but it works
 
-		// Convert int fields. TODO: handle error
-		ptsWin, _ := strconv.Atoi(row.PtsWin)
-		ptsLose, _ := strconv.Atoi(row.PtsLose)
-		yardsWin, _ := strconv.Atoi(row.YardsWin)
-		yardsLose, _ := strconv.Atoi(row.YardsLose)
-		toWin, _ := strconv.Atoi(row.ToWin)
-		toLose, _ := strconv.Atoi(row.ToLose)
-
-		// Create the game
-		g := game.Game{
-			Time:      t,
-			Winner:    row.Winner,
-			Loser:     row.Loser,
-			Home:      home,
-			Away:      away,
-			PtsWin:    ptsWin,
-			PtsLose:   ptsLose,
-			YardsWin:  yardsWin,
-			YardsLose: yardsLose,
-			ToWin:     toWin,
-			ToLose:    toLose,
+		// Games that have not been played yet are kept apart from the results
+		if !row.Played() {
+			week.Remaining = append(week.Remaining, g)
+			continue
 		}
 
 		// Add the game to the week
@@ -92,12 +155,65 @@ func CreateSchedule(rows []scraper.ScrapedRow) Schedule {
 			week.Games = make([]game.Game, 0)
 		}
 		week.Games = append(week.Games, g)
-		week.Number = weekNum // TODO: Don't need to do this every loop, but it works
 	}
 
 	return Schedule{Weeks: weeks}
 }
 
+// GameFromRow converts a scraped row into a game
+func GameFromRow(row scraper.ScrapedRow) game.Game {
+	// Parse the time
+	t := scraper.ParseTime(row)
+
+	// Determine home/away. Winner is listed first, @ is used optionally
+	var home, away string
+	if row.GameLocation == "@" {
+		home = row.Loser
+		away = row.Winner
+	} else {
+		home = row.Winner
+		away = row.Loser
+	}
+
+	// Convert int fields. TODO: handle error
+	ptsWin, _ := strconv.Atoi(row.PtsWin)
+	ptsLose, _ := strconv.Atoi(row.PtsLose)
+	yardsWin, _ := strconv.Atoi(row.YardsWin)
+	yardsLose, _ := strconv.Atoi(row.YardsLose)
+	toWin, _ := strconv.Atoi(row.ToWin)
+	toLose, _ := strconv.Atoi(row.ToLose)
+
+	g := game.Game{
+		Time:      t,
+		Winner:    row.Winner,
+		Loser:     row.Loser,
+		Home:      home,
+		Away:      away,
+		PtsWin:    ptsWin,
+		PtsLose:   ptsLose,
+		YardsWin:  yardsWin,
+		YardsLose: yardsLose,
+		ToWin:     toWin,
+		ToLose:    toLose,
+	}
+
+	switch {
+	case !row.Played():
+		// No result yet, only the matchup is known
+		g = game.Game{Time: t, Home: home, Away: away}
+	case ptsWin == ptsLose:
+		// Ties have no winner or loser, and the *Win fields belong to the home team
+		g.Winner = ""
+		g.Loser = ""
+		if home != row.Winner {
+			g.YardsWin, g.YardsLose = g.YardsLose, g.YardsWin
+			g.ToWin, g.ToLose = g.ToLose, g.ToWin
+		}
+	}
+
+	return g
+}
+
 func (s *Schedule) Print() {
 	for _, week := range s.Weeks {
 		fmt.Printf("Week %d\n", week.Number)
@@ -124,6 +240,14 @@ func (s *Schedule) SplitToTeams() map[string]Schedule {
 			awaySchedule := teamSchedules[game.Away]
 			awaySchedule.Weeks[i].Games = append(awaySchedule.Weeks[i].Games, game)
 		}
+
+		for _, game := range week.Remaining {
+			homeSchedule := teamSchedules[game.Home]
+			homeSchedule.Weeks[i].Remaining = append(homeSchedule.Weeks[i].Remaining, game)
+
+			awaySchedule := teamSchedules[game.Away]
+			awaySchedule.Weeks[i].Remaining = append(awaySchedule.Weeks[i].Remaining, game)
+		}
 	}
 
 	return teamSchedules
@@ -146,6 +270,29 @@ func (s *Schedule) OpponentMapFor(team string) map[string][]game.Game {
 	return oppMap
 }
 
+// RankPoints sets the league and conference ranks in points scored and allowed of every entry
+// Tied teams share the rank
+func RankPoints(entries []entry.Entry) {
+	leagueRankPf := Ranking(entries, pointsFor)
+	leagueRankPa := Ranking(entries, pointsAgainst)
+
+	confRankPf := make(map[string]int)
+	confRankPa := make(map[string]int)
+	for _, conf := range team.Conferences {
+		confEntries := entry.ConferenceEntries(entries, conf)
+		maps.Copy(confRankPf, Ranking(confEntries, pointsFor))
+		maps.Copy(confRankPa, Ranking(confEntries, pointsAgainst))
+	}
+
+	for i := range entries {
+		e := &entries[i]
+		e.Stats.LeagueRankPointsFor = leagueRankPf[e.Team.Name]
+		e.Stats.LeagueRankPointsAgainst = leagueRankPa[e.Team.Name]
+		e.Stats.ConferenceRankPointsFor = confRankPf[e.Team.Name]
+		e.Stats.ConferenceRankPointsAgainst = confRankPa[e.Team.Name]
+	}
+}
+
 // CreateEntries will create a slice of entries representing the given schedule
 // Note that this slice is not guaranteed to contain an entry for every team, only
 // those teams in involved in the given schedule
@@ -174,47 +321,25 @@ func CreateEntries(schedule Schedule) []entry.Entry {
 	// To do this, split the schedule into individual team schedules
 	teamSchedules := schedule.SplitToTeams()
 
-	// Get slice of entries
+	// Get slice of entries, ordered by team name so the result does not depend on map order
 	entries := make([]entry.Entry, 0)
 	for _, entry := range entryMap {
 		entries = append(entries, *entry)
 	}
+	slices.SortFunc(entries, func(a, b entry.Entry) int {
+		return strings.Compare(a.Team.Name, b.Team.Name)
+	})
 
 	// SOV and SOS
-	for i, entry := range entries {
-		sov := StrengthOfVictory(entry.Team.Name, entries, teamSchedules)
-		sos := StrengthOfSchedule(entry.Team.Name, entries, teamSchedules)
-
-		entry.Stats.StrengthOfVictory = sov
-		entry.Stats.StrengthOfSchedule = sos
-
-		// TODO: Use pointer to avoid this?
-		entries[i] = entry
-	}
-
-	// League rank maps
-	leagueRankPf := Ranking(entries, pointsFor)
-	leagueRankPa := Ranking(entries, pointsAgainst)
-
-	// Conference rank maps
-	confRankPf := make(map[string]int)
-	confRankPa := make(map[string]int)
-	for _, conf := range team.Conferences {
-		confEntries := entry.ConferenceEntries(entries, conf)
-		maps.Copy(confRankPf, Ranking(confEntries, pointsFor))
-		maps.Copy(confRankPa, Ranking(confEntries, pointsAgainst))
+	byTeam := entry.ByTeam(entries)
+	for i := range entries {
+		entries[i].Stats.StrengthOfVictory, entries[i].Stats.StrengthOfSchedule =
+			Strengths(entries[i].Team.Name, byTeam, teamSchedules)
+		entries[i].Stats.RemainingStrengthOfSchedule = RemainingStrength(entries[i].Team.Name, byTeam, teamSchedules)
 	}
 
-	// Populate entries using maps
-	for i, entry := range entries {
-		entry.Stats.LeagueRankPointsFor = leagueRankPf[entry.Team.Name]
-		entry.Stats.LeagueRankPointsAgainst = leagueRankPa[entry.Team.Name]
-
-		entry.Stats.ConferenceRankPointsFor = confRankPf[entry.Team.Name]
-		entry.Stats.ConferenceRankPointsAgainst = confRankPa[entry.Team.Name]
-
-		entries[i] = entry
-	}
+	RankPoints(entries)
+	RateEntries(entries, teamSchedules)
 
 	return entries
 }
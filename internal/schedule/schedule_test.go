@@ -0,0 +1,65 @@
+package schedule
+
+import (
+	"nfl-app/internal/game"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestThrough(t *testing.T) {
+	kickoff := time.Date(2024, 9, 22, 13, 0, 0, 0, time.UTC)
+	sched := NewSchedule()
+	sched.AddGame(1, game.Game{Home: "A", Away: "B", Winner: "A", Loser: "B", PtsWin: 20, PtsLose: 10})
+	sched.AddGame(2, game.Game{Home: "B", Away: "A", Winner: "A", Loser: "B", PtsWin: 27, PtsLose: 24})
+	sched.AddGame(3, game.Game{Time: kickoff, Home: "A", Away: "C", Winner: "C", Loser: "A", PtsWin: 13, PtsLose: 6, YardsWin: 300})
+	sched.AddRemaining(3, game.Game{Home: "B", Away: "D"})
+	sched.AddRemaining(4, game.Game{Home: "C", Away: "A"})
+	before := sched.Clone()
+
+	through := sched.Through(2)
+	if !reflect.DeepEqual(sched, before) {
+		t.Errorf("expected Through to leave the schedule unchanged")
+	}
+	if len(through.Weeks) != len(sched.Weeks) || through.LastPlayedWeek() != 2 {
+		t.Fatalf("expected all %d weeks with results up to week 2, got %d weeks up to %d",
+			len(sched.Weeks), len(through.Weeks), through.LastPlayedWeek())
+	}
+	for week := 1; week <= 2; week++ {
+		if !reflect.DeepEqual(through.Weeks[week-1], sched.Weeks[week-1]) {
+			t.Errorf("expected week %d to be kept as it was", week)
+		}
+	}
+
+	// The week 3 result goes back to being a remaining game, keeping only its teams and kickoff
+	want := []ScheduledGame{
+		{Week: 3, Game: game.Game{Home: "B", Away: "D"}},
+		{Week: 3, Game: game.Game{Time: kickoff, Home: "A", Away: "C"}},
+		{Week: 4, Game: game.Game{Home: "C", Away: "A"}},
+	}
+	if got := through.RemainingGames(); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected remaining games\n%+v\ngot\n%+v", want, got)
+	}
+
+	tests := []struct {
+		week   int
+		played int
+		left   int
+	}{
+		{-1, 0, 5},
+		{0, 0, 5},
+		{3, 3, 2},
+		{len(sched.Weeks), 3, 2},
+		{len(sched.Weeks) + 1, 3, 2},
+	}
+	for _, tt := range tests {
+		through := sched.Through(tt.week)
+		played := 0
+		for _, w := range through.Weeks {
+			played += len(w.Games)
+		}
+		if left := len(through.RemainingGames()); played != tt.played || left != tt.left {
+			t.Errorf("Through(%d): expected %d played and %d left, got %d and %d", tt.week, tt.played, tt.left, played, left)
+		}
+	}
+}
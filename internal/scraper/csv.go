@@ -0,0 +1,82 @@
+package scraper
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// rowColumns are the CSV columns of a scraped row, named after the ScrapedRow fields
+var rowColumns = []string{
+	"Week", "DayOfWeek", "Date", "Gametime", "Winner", "GameLocation", "Loser",
+	"PtsWin", "PtsLose", "YardsWin", "YardsLose", "ToWin", "ToLose",
+}
+
+func rowFields(row *ScrapedRow) []*string {
+	return []*string{
+		&row.Week, &row.DayOfWeek, &row.Date, &row.Gametime, &row.Winner, &row.GameLocation, &row.Loser,
+		&row.PtsWin, &row.PtsLose, &row.YardsWin, &row.YardsLose, &row.ToWin, &row.ToLose,
+	}
+}
+
+// ReadCSV reads rows from a CSV file with a header row. Columns may come in any order, and missing columns are left empty
+func ReadCSV(r io.Reader) ([]ScrapedRow, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("reading CSV header: %w", err)
+	}
+
+	index := make(map[string]int)
+	for i, name := range header {
+		index[strings.TrimSpace(name)] = i
+	}
+	for _, required := range []string{"Week", "Winner", "Loser"} {
+		if _, ok := index[required]; !ok {
+			return nil, fmt.Errorf("CSV is missing the %s column", required)
+		}
+	}
+
+	rows := make([]ScrapedRow, 0)
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var row ScrapedRow
+		fields := rowFields(&row)
+		for c, name := range rowColumns {
+			if i, ok := index[name]; ok && i < len(record) {
+				*fields[c] = record[i]
+			}
+		}
+		rows = append(rows, row)
+	}
+}
+
+// WriteCSV writes rows in the format read by ReadCSV, with a header row
+func WriteCSV(w io.Writer, rows []ScrapedRow) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(rowColumns); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		fields := rowFields(&row)
+		record := make([]string, len(fields))
+		for i, f := range fields {
+			record[i] = *f
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
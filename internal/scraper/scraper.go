@@ -3,6 +3,9 @@ package scraper
 import (
 	"fmt"
 	"log"
+	"net/http"
+	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/gocolly/colly"
@@ -28,15 +31,86 @@ type ScrapedRow struct {
 	ToLose       string
 }
 
+// Played reports whether the row holds the result of a completed game
+// Rows for games that have not been played yet have no points
+func (r ScrapedRow) Played() bool {
+	return r.PtsWin != ""
+}
+
 // Note: ScrapeYear will include games that have not been played yet
 // It is expected that the caller of this function will handle that accordingly
 func ScrapeYear(year string) ([]ScrapedRow, error) {
-	// Create a new collector
+	// For now, ignore playoff games
+	return scrape(year, func(week string) bool {
+		return !IsPlayoffWeek(week)
+	})
+}
+
+// ScrapeSeason returns every row for the given year, regular season and playoffs
+// Use IsPlayoffWeek to tell them apart
+func ScrapeSeason(year string) ([]ScrapedRow, error) {
+	return scrape(year, func(string) bool {
+		return true
+	})
+}
+
+// ScrapePlayoffs returns only the playoff rows for the given year
+func ScrapePlayoffs(year string) ([]ScrapedRow, error) {
+	return scrape(year, IsPlayoffWeek)
+}
+
+// ScrapeFile returns every row from a saved copy of a season's games page, regular season and playoffs,
+// without going to the network
+func ScrapeFile(path string) ([]ScrapedRow, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	// The file transport answers a missing file with a 404 page rather than an error, so check it exists first
+	if _, err := os.Stat(abs); err != nil {
+		return nil, err
+	}
+
+	t := &http.Transport{}
+	t.RegisterProtocol("file", http.NewFileTransport(http.Dir("/")))
 	c := colly.NewCollector()
+	c.WithTransport(t)
+
+	return collect(c, "file://"+filepath.ToSlash(abs), func(string) bool {
+		return true
+	})
+}
+
+// IsPlayoffWeek reports whether the scraped week label belongs to a playoff round
+func IsPlayoffWeek(week string) bool {
+	return week == "WildCard" || week == "Division" ||
+		week == "ConfChamp" || week == "SuperBowl"
+}
 
+// SplitPlayoffs separates regular season rows from playoff rows, keeping their order
+func SplitPlayoffs(rows []ScrapedRow) (regular, playoffs []ScrapedRow) {
+	regular = make([]ScrapedRow, 0, len(rows))
+	playoffs = make([]ScrapedRow, 0)
+	for _, row := range rows {
+		if IsPlayoffWeek(row.Week) {
+			playoffs = append(playoffs, row)
+		} else {
+			regular = append(regular, row)
+		}
+	}
+	return regular, playoffs
+}
+
+// scrape visits the games table for the given year on pro-football-reference.com, keeping the rows whose week label satisfies keep
+func scrape(year string, keep func(week string) bool) ([]ScrapedRow, error) {
 	// Define the URL (replace YEAR with the desired season)
 	url := fmt.Sprintf("https://www.pro-football-reference.com/years/%s/games.htm", year)
 
+	return collect(colly.NewCollector(), url, keep)
+}
+
+// collect visits the games table at the URL with the given collector, keeping the rows whose week label satisfies keep
+func collect(c *colly.Collector, url string, keep func(week string) bool) ([]ScrapedRow, error) {
 	scrapedRows := make([]ScrapedRow, 0)
 
 	// Define what to do when visiting a row in the games table
@@ -52,9 +126,7 @@ func ScrapeYear(year string) ([]ScrapedRow, error) {
 			return
 		}
 
-		// For now, ignore playoff games
-		if week == "WildCard" || week == "Division" ||
-			week == "ConfChamp" || week == "SuperBowl" {
+		if !keep(week) {
 			return
 		}
 
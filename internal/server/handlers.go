@@ -0,0 +1,276 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"nfl-app/internal/draft"
+	"nfl-app/internal/entry"
+	"nfl-app/internal/entrysort"
+	"nfl-app/internal/stats"
+	"nfl-app/internal/team"
+	"nfl-app/internal/whatif"
+	"strconv"
+	"strings"
+)
+
+// maxBodyBytes limits the size of a what-if request
+const maxBodyBytes = 1 << 20
+
+// Division is the JSON shape of a division's standings, ordered by division rank
+type Division struct {
+	Division string        `json:"division"`
+	Entries  []entry.Entry `json:"entries"`
+}
+
+// StandingsResponse is returned by /seasons/{year}/standings
+// With ?sort=COLUMN, Teams also lists every team ordered by that stat, best first
+type StandingsResponse struct {
+	Season    int           `json:"season"`
+	Divisions []Division    `json:"divisions"`
+	Teams     []entry.Entry `json:"teams,omitempty"`
+}
+
+// SeedsResponse is returned by /seasons/{year}/seeds/{conf}
+// Every team in the conference is included, in seed order
+type SeedsResponse struct {
+	Season     int              `json:"season"`
+	Conference string           `json:"conference"`
+	Seeds      []entry.Entry    `json:"seeds"`
+	Trace      *entrysort.Trace `json:"trace,omitempty"`
+}
+
+// TiebreakResponse is returned by /seasons/{year}/tiebreak
+type TiebreakResponse struct {
+	Season int              `json:"season"`
+	Order  []entry.Entry    `json:"order"`
+	Trace  *entrysort.Trace `json:"trace"`
+}
+
+// Pick is a single selection in the draft order
+type Pick struct {
+	Pick  int         `json:"pick"`
+	Entry entry.Entry `json:"entry"`
+
+	// Exit is the playoff round the team went out in, empty if it missed the playoffs
+	Exit string `json:"exit,omitempty"`
+}
+
+// DraftOrderResponse is returned by /seasons/{year}/draft-order
+type DraftOrderResponse struct {
+	Season int    `json:"season"`
+	Final  bool   `json:"final"`
+	Picks  []Pick `json:"picks"`
+}
+
+// WhatIfRequest is the body of POST /whatif. Teams may be given by name, abbreviation or nickname
+type WhatIfRequest struct {
+	Season int           `json:"season"`
+	Picks  []whatif.Pick `json:"picks"`
+}
+
+// WhatIfResponse is returned by POST /whatif, with the seeds and seeding trace of each conference
+type WhatIfResponse struct {
+	Season      int             `json:"season"`
+	Conferences []SeedsResponse `json:"conferences"`
+}
+
+// errorResponse is returned with every error status
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Println("writing response:", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, errorResponse{Error: err.Error()})
+}
+
+// loadSeason parses the year from the path and returns its season, writing an error response if it can't
+func (s *Server) loadSeason(w http.ResponseWriter, year string) (*season, bool) {
+	y, err := strconv.Atoi(year)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("bad season %q", year))
+		return nil, false
+	}
+	return s.loadYear(w, y)
+}
+
+func (s *Server) loadYear(w http.ResponseWriter, year int) (*season, bool) {
+	data, err := s.season(year)
+	switch {
+	case errors.Is(err, ErrUnknownSeason):
+		writeError(w, http.StatusNotFound, err)
+		return nil, false
+	case err != nil:
+		writeError(w, http.StatusInternalServerError, err)
+		return nil, false
+	}
+	return data, true
+}
+
+func (s *Server) handleStandings(w http.ResponseWriter, r *http.Request) {
+	data, ok := s.loadSeason(w, r.PathValue("year"))
+	if !ok {
+		return
+	}
+
+	resp := StandingsResponse{Season: data.year, Divisions: make([]Division, 0, len(team.Divisions))}
+	for _, div := range team.Divisions {
+		resp.Divisions = append(resp.Divisions, Division{
+			Division: div,
+			Entries:  data.standings.Division(div),
+		})
+	}
+	if name := r.URL.Query().Get("sort"); name != "" {
+		column, ok := stats.LookupColumn(name)
+		if !ok {
+			writeError(w, http.StatusBadRequest, fmt.Errorf("unknown sort column %q, expected one of %s", name,
+				strings.Join(stats.ColumnNames(), ", ")))
+			return
+		}
+		resp.Teams = entry.SortByColumn(data.standings.Entries, column)
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) handleSeeds(w http.ResponseWriter, r *http.Request) {
+	conf, ok := team.LookupConference(r.PathValue("conf"))
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Errorf("unknown conference %q", r.PathValue("conf")))
+		return
+	}
+	data, ok := s.loadSeason(w, r.PathValue("year"))
+	if !ok {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, SeedsResponse{
+		Season:     data.year,
+		Conference: conf,
+		Seeds:      data.standings.Conference(conf),
+	})
+}
+
+// handleTiebreak breaks the tie between the teams given in the teams query parameter,
+// either comma separated or repeated
+func (s *Server) handleTiebreak(w http.ResponseWriter, r *http.Request) {
+	teams := make([]team.Team, 0)
+	for _, param := range r.URL.Query()["teams"] {
+		for _, name := range strings.Split(param, ",") {
+			t, ok := team.Lookup(strings.TrimSpace(name))
+			if !ok {
+				writeError(w, http.StatusBadRequest, fmt.Errorf("unknown team %q", name))
+				return
+			}
+			teams = append(teams, t)
+		}
+	}
+	if len(teams) < 2 {
+		writeError(w, http.StatusBadRequest, errors.New("give at least two teams, e.g. ?teams=BUF,MIA"))
+		return
+	}
+
+	data, ok := s.loadSeason(w, r.PathValue("year"))
+	if !ok {
+		return
+	}
+
+	entries := entry.FilterEntries(data.standings.Entries, teams)
+	sorted, trace, err := entrysort.SortEntriesTrace(entries, data.standings.TeamSchedules)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, TiebreakResponse{
+		Season: data.year,
+		Order:  sorted,
+		Trace:  trace,
+	})
+}
+
+func (s *Server) handleDraftOrder(w http.ResponseWriter, r *http.Request) {
+	data, ok := s.loadSeason(w, r.PathValue("year"))
+	if !ok {
+		return
+	}
+
+	ds, err := draft.FromSeason(data.year, data.schedule, data.playoffGames)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	resp := DraftOrderResponse{Season: data.year, Final: ds.Final, Picks: make([]Pick, len(ds.Order))}
+	for i, e := range ds.Order {
+		resp.Picks[i] = Pick{
+			Pick:  i + 1,
+			Entry: e,
+			Exit:  draft.ExitName(ds.Exits[e.Team.Name], ds.Final),
+		}
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (s *Server) handleWhatIf(w http.ResponseWriter, r *http.Request) {
+	var req WhatIfRequest
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodyBytes))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("bad request body: %w", err))
+		return
+	}
+
+	for i := range req.Picks {
+		if err := resolvePick(&req.Picks[i]); err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+	}
+
+	data, ok := s.loadYear(w, req.Season)
+	if !ok {
+		return
+	}
+
+	result, err := whatif.Evaluate(data.schedule, req.Picks)
+	if err != nil {
+		writeError(w, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	resp := WhatIfResponse{Season: data.year}
+	for _, conf := range team.Conferences {
+		resp.Conferences = append(resp.Conferences, SeedsResponse{
+			Season:     data.year,
+			Conference: conf,
+			Seeds:      result.Standings.Conference(conf),
+			Trace:      result.Traces[conf],
+		})
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// resolvePick replaces the team names in the pick with full names
+func resolvePick(p *whatif.Pick) error {
+	for _, name := range []*string{&p.Home, &p.Away, &p.Winner} {
+		if *name == "" {
+			continue
+		}
+		t, ok := team.Lookup(*name)
+		if !ok {
+			return fmt.Errorf("unknown team %q", *name)
+		}
+		*name = t.Name
+	}
+	return nil
+}
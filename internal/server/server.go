@@ -0,0 +1,156 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"nfl-app/internal/game"
+	"nfl-app/internal/playoff"
+	"nfl-app/internal/schedule"
+	"nfl-app/internal/scraper"
+	"nfl-app/internal/standings"
+	"sync"
+	"time"
+)
+
+// ErrUnknownSeason is returned by a Loader that has no data for the requested season
+var ErrUnknownSeason = errors.New("unknown season")
+
+// FirstSeason is the earliest season served, the first with the league's current eight divisions of four teams
+const FirstSeason = 2002
+
+// maxCachedSeasons is the most seasons kept at once. Past it, the season used longest ago is dropped
+const maxCachedSeasons = 8
+
+// Loader returns every scraped row (regular season and playoffs) for a season
+type Loader func(year int) ([]scraper.ScrapedRow, error)
+
+// Server serves standings, seeds, tiebreakers, the draft order and what-if scenarios as JSON
+//
+// Each season is loaded and ranked once, then kept for TTL before being loaded again,
+// so a season in progress picks up new results. Only seasons from FirstSeason to the current year are loaded, and at
+// most maxCachedSeasons are kept
+type Server struct {
+	load Loader
+	ttl  time.Duration
+
+	mu         sync.Mutex
+	seasons    map[int]*cachedSeason
+	maxSeasons int
+}
+
+// cachedSeason holds a loaded season. ready is closed once loading is done, so concurrent
+// requests for the same season wait for a single load instead of each starting their own
+type cachedSeason struct {
+	ready  chan struct{}
+	loaded time.Time
+	data   *season
+	err    error
+
+	// used is when the season was last asked for, guarded by the server's mutex
+	used time.Time
+}
+
+// season is everything the handlers need for a single season
+type season struct {
+	year         int
+	schedule     schedule.Schedule
+	standings    standings.Standings
+	playoffGames []game.Game
+}
+
+// New returns a server loading seasons with the given loader and caching each one for ttl
+func New(load Loader, ttl time.Duration) *Server {
+	return &Server{
+		load:       load,
+		ttl:        ttl,
+		seasons:    make(map[int]*cachedSeason),
+		maxSeasons: maxCachedSeasons,
+	}
+}
+
+// Handler returns the HTTP handler for every endpoint
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /seasons/{year}/standings", s.handleStandings)
+	mux.HandleFunc("GET /seasons/{year}/seeds/{conf}", s.handleSeeds)
+	mux.HandleFunc("GET /seasons/{year}/tiebreak", s.handleTiebreak)
+	mux.HandleFunc("GET /seasons/{year}/draft-order", s.handleDraftOrder)
+	mux.HandleFunc("POST /whatif", s.handleWhatIf)
+	return mux
+}
+
+// season returns the cached season, loading it if it is missing or has expired
+func (s *Server) season(year int) (*season, error) {
+	if latest := time.Now().Year(); year < FirstSeason || year > latest {
+		return nil, fmt.Errorf("%w %d, seasons go from %d to %d", ErrUnknownSeason, year, FirstSeason, latest)
+	}
+
+	s.mu.Lock()
+	cached, ok := s.seasons[year]
+	if ok {
+		select {
+		case <-cached.ready:
+			// Reload expired seasons, and retry failed loads
+			if cached.err != nil || time.Since(cached.loaded) > s.ttl {
+				ok = false
+			}
+		default:
+			// Still loading
+		}
+	}
+	if !ok {
+		if _, reloading := s.seasons[year]; !reloading && len(s.seasons) >= s.maxSeasons {
+			s.evict()
+		}
+		cached = &cachedSeason{ready: make(chan struct{})}
+		s.seasons[year] = cached
+		go s.fill(year, cached)
+	}
+	cached.used = time.Now()
+	s.mu.Unlock()
+
+	<-cached.ready
+	return cached.data, cached.err
+}
+
+// evict drops the season used longest ago. Requests already waiting on it still get it. s.mu must be held
+func (s *Server) evict() {
+	oldest, found := 0, false
+	for year, cached := range s.seasons {
+		if !found || cached.used.Before(s.seasons[oldest].used) {
+			oldest, found = year, true
+		}
+	}
+	delete(s.seasons, oldest)
+}
+
+func (s *Server) fill(year int, cached *cachedSeason) {
+	defer close(cached.ready)
+	cached.data, cached.err = s.fetch(year)
+	cached.loaded = time.Now()
+}
+
+func (s *Server) fetch(year int) (*season, error) {
+	rows, err := s.load(year)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("%w %d", ErrUnknownSeason, year)
+	}
+
+	regular, playoffs := scraper.SplitPlayoffs(rows)
+	sched := schedule.CreateSchedule(regular)
+	st, err := standings.Compute(sched)
+	if err != nil {
+		return nil, err
+	}
+
+	return &season{
+		year:         year,
+		schedule:     sched,
+		standings:    st,
+		playoffGames: playoff.GamesFromRows(playoffs),
+	}, nil
+}
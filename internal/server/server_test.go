@@ -0,0 +1,143 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"nfl-app/internal/entry"
+	"nfl-app/internal/scraper"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestServer(t *testing.T) {
+	var loads atomic.Int32
+	load := func(year int) ([]scraper.ScrapedRow, error) {
+		loads.Add(1)
+		if year != 2024 {
+			return nil, nil
+		}
+		return []scraper.ScrapedRow{
+			{Week: "1", Winner: "Buffalo Bills", Loser: "Miami Dolphins", PtsWin: "24", PtsLose: "17"},
+			{Week: "2", Winner: "Miami Dolphins", GameLocation: "@", Loser: "Buffalo Bills", PtsWin: "20", PtsLose: "10",
+				YardsWin: "310", YardsLose: "280", ToWin: "0", ToLose: "3"},
+			{Week: "3", Winner: "Kansas City Chiefs", Loser: "Denver Broncos"},
+		}, nil
+	}
+
+	ts := httptest.NewServer(New(load, time.Hour).Handler())
+	defer ts.Close()
+
+	get := func(path string, want int, into any) {
+		t.Helper()
+		resp, err := http.Get(ts.URL + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != want {
+			t.Fatalf("GET %s: got status %d, want %d", path, resp.StatusCode, want)
+		}
+		if into == nil {
+			io.Copy(io.Discard, resp.Body)
+		} else {
+			if err := json.NewDecoder(resp.Body).Decode(into); err != nil {
+				t.Fatalf("GET %s: %v", path, err)
+			}
+		}
+	}
+
+	var standings StandingsResponse
+	get("/seasons/2024/standings", http.StatusOK, &standings)
+	if len(standings.Divisions) != 8 {
+		t.Errorf("expected 8 divisions, got %d", len(standings.Divisions))
+	}
+
+	var sorted StandingsResponse
+	get("/seasons/2024/standings?sort=turnoverDifferential", http.StatusOK, &sorted)
+	if n := len(sorted.Teams); n != 32 || sorted.Teams[0].Team.Name != "Miami Dolphins" || sorted.Teams[n-1].Team.Name != "Buffalo Bills" {
+		t.Errorf("expected every team, the Dolphins first and the Bills last by turnover differential, got %s", entry.Teams(sorted.Teams))
+	}
+	if len(standings.Teams) != 0 {
+		t.Errorf("expected no sorted teams without ?sort, got %d", len(standings.Teams))
+	}
+	get("/seasons/2024/standings?sort=bogus", http.StatusBadRequest, nil)
+
+	var tiebreak TiebreakResponse
+	get("/seasons/2024/tiebreak?teams=BUF,mia", http.StatusOK, &tiebreak)
+	if len(tiebreak.Order) != 2 || len(tiebreak.Trace.Steps) == 0 {
+		t.Errorf("expected two teams and a trace, got %+v", tiebreak)
+	}
+
+	get("/seasons/2024/seeds/AFC", http.StatusOK, nil)
+	get("/seasons/2024/seeds/XFL", http.StatusNotFound, nil)
+	get("/seasons/2010/standings", http.StatusNotFound, nil)
+	get("/seasons/2010/draft-order", http.StatusNotFound, nil)
+	get("/seasons/1999/standings", http.StatusNotFound, nil)
+	get("/seasons/3000/standings", http.StatusNotFound, nil)
+	get("/seasons/abc/standings", http.StatusBadRequest, nil)
+	get("/seasons/2024/tiebreak?teams=BUF", http.StatusBadRequest, nil)
+
+	// The Chiefs and Broncos game in week 3 has not been played
+	body := `{"season": 2024, "picks": [{"home": "DEN", "away": "KC", "winner": "Broncos"}]}`
+	resp, err := http.Post(ts.URL+"/whatif", "application/json", strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("POST /whatif: got status %d", resp.StatusCode)
+	}
+
+	// 2024 is loaded once and served from the cache after that, 2010 has no data so it is retried, and 1999 and 3000
+	// are never loaded
+	if got := loads.Load(); got != 3 {
+		t.Errorf("expected 3 loads, got %d", got)
+	}
+}
+
+func TestSeasonCache(t *testing.T) {
+	loads := make(map[int]int)
+	load := func(year int) ([]scraper.ScrapedRow, error) {
+		loads[year]++
+		return []scraper.ScrapedRow{{Week: "1", Winner: "Buffalo Bills", Loser: "Miami Dolphins", PtsWin: "24", PtsLose: "17"}}, nil
+	}
+	s := New(load, time.Hour)
+	s.maxSeasons = 2
+
+	season := func(year int) {
+		t.Helper()
+		if _, err := s.season(year); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// 2021 is used longest ago when 2022 comes in, so it is the one dropped
+	season(2020)
+	season(2021)
+	season(2020)
+	season(2022)
+	if len(s.seasons) != 2 {
+		t.Errorf("expected 2 cached seasons, got %d", len(s.seasons))
+	}
+	season(2020)
+	season(2021)
+	if want := map[int]int{2020: 1, 2021: 2, 2022: 1}; !maps.Equal(loads, want) {
+		t.Errorf("expected loads %v, got %v", want, loads)
+	}
+
+	for _, year := range []int{FirstSeason - 1, time.Now().Year() + 1} {
+		if _, err := s.season(year); !errors.Is(err, ErrUnknownSeason) {
+			t.Errorf("expected %d to be an unknown season, got %v", year, err)
+		}
+		if loads[year] != 0 {
+			t.Errorf("expected %d not to be loaded", year)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package simulate
+
+import (
+	"fmt"
+	"math"
+	"nfl-app/internal/elo"
+	"nfl-app/internal/game"
+)
+
+// WinModel decides how likely the home team is to win a game that has not been played yet
+// Ties are not simulated, so the away team wins with the remaining probability
+type WinModel interface {
+	HomeWinProbability(g game.Game) float64
+}
+
+// CoinFlip gives every team an equal chance of winning every game
+type CoinFlip struct{}
+
+func (CoinFlip) HomeWinProbability(game.Game) float64 {
+	return 0.5
+}
+
+// EloModel picks winners using team Elo ratings, expecting the result elo.ExpectedHome gives
+// Teams without a rating are given Options.Initial
+type EloModel struct {
+	Ratings elo.Ratings
+	Options elo.Options
+}
+
+// NewEloModel returns an EloModel with elo.DefaultOptions
+func NewEloModel(ratings elo.Ratings) *EloModel {
+	return &EloModel{
+		Ratings: ratings,
+		Options: elo.DefaultOptions(),
+	}
+}
+
+func (m *EloModel) HomeWinProbability(g game.Game) float64 {
+	return elo.ExpectedHome(m.Ratings.Rating(g.Home, m.Options), m.Ratings.Rating(g.Away, m.Options), m.Options)
+}
+
+// SpreadModel picks winners using point spreads, assuming the final margin is normally
+// distributed around the spread. Games without a spread are treated as pick'em
+type SpreadModel struct {
+	// Spreads holds the expected home margin of victory for each game, keyed by SpreadKey
+	// A home team favored by 3 points has a spread of 3
+	Spreads map[string]float64
+
+	// StdDev is the standard deviation of the final margin around the spread
+	StdDev float64
+}
+
+// NewSpreadModel returns a SpreadModel with the commonly used 13.5 point standard deviation
+func NewSpreadModel(spreads map[string]float64) *SpreadModel {
+	return &SpreadModel{
+		Spreads: spreads,
+		StdDev:  13.5,
+	}
+}
+
+// SpreadKey returns the key used to look up the spread for a game between the given teams
+func SpreadKey(home, away string) string {
+	return fmt.Sprintf("%s@%s", away, home)
+}
+
+func (m *SpreadModel) HomeWinProbability(g game.Game) float64 {
+	spread := m.Spreads[SpreadKey(g.Home, g.Away)]
+
+	// Normal CDF of the spread
+	return 0.5 * (1 + math.Erf(spread/(m.StdDev*math.Sqrt2)))
+}
@@ -0,0 +1,263 @@
+package simulate
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"nfl-app/internal/draft"
+	"nfl-app/internal/game"
+	"nfl-app/internal/playoff"
+	"nfl-app/internal/schedule"
+	"nfl-app/internal/standings"
+	"nfl-app/internal/team"
+	"runtime"
+	"sync"
+)
+
+// Options control a simulation run
+type Options struct {
+	// Iterations is the number of seasons to simulate
+	Iterations int
+
+	// Seed makes a run reproducible. The same seed and schedule always give the same result,
+	// regardless of the number of workers
+	Seed uint64
+
+	// Workers is the number of goroutines simulating seasons, defaults to the number of CPUs
+	Workers int
+
+	// Model picks the winner of each remaining game, defaults to CoinFlip
+	Model WinModel
+}
+
+// Odds holds the share of simulated seasons in which a team reached each outcome
+type Odds struct {
+	Team team.Team
+
+	Playoffs      float64
+	Division      float64
+	FirstRoundBye float64
+
+	// Seeds holds the odds of each playoff seed, index 0 being the 1 seed
+	Seeds []float64
+
+	// DraftSlots holds the odds of each draft slot, index 0 being the first pick
+	DraftSlots []float64
+}
+
+// Result is the outcome of a simulation run, keyed by team name
+type Result struct {
+	Iterations int
+	Teams      map[string]Odds
+}
+
+// tally counts outcomes across simulated seasons
+type tally struct {
+	playoffs   map[string]int
+	division   map[string]int
+	bye        map[string]int
+	seeds      map[string][]int
+	draftSlots map[string][]int
+}
+
+func newTally() *tally {
+	t := &tally{
+		playoffs:   make(map[string]int),
+		division:   make(map[string]int),
+		bye:        make(map[string]int),
+		seeds:      make(map[string][]int),
+		draftSlots: make(map[string][]int),
+	}
+	for _, tm := range team.NFLTeams {
+		t.seeds[tm.Name] = make([]int, playoff.SeedsPerConference)
+		t.draftSlots[tm.Name] = make([]int, len(team.NFLTeams))
+	}
+	return t
+}
+
+func (t *tally) merge(other *tally) {
+	for name, count := range other.playoffs {
+		t.playoffs[name] += count
+	}
+	for name, count := range other.division {
+		t.division[name] += count
+	}
+	for name, count := range other.bye {
+		t.bye[name] += count
+	}
+	for name, counts := range other.seeds {
+		for i, count := range counts {
+			t.seeds[name][i] += count
+		}
+	}
+	for name, counts := range other.draftSlots {
+		for i, count := range counts {
+			t.draftSlots[name][i] += count
+		}
+	}
+}
+
+// Run simulates the remaining games of the schedule opts.Iterations times, seeding each simulated season,
+// playing out its playoffs and working out the draft order
+func Run(sched schedule.Schedule, opts Options) (Result, error) {
+	if opts.Iterations < 1 {
+		return Result{}, fmt.Errorf("iterations must be positive, got %d", opts.Iterations)
+	}
+	if opts.Model == nil {
+		opts.Model = CoinFlip{}
+	}
+	workers := opts.Workers
+	if workers < 1 {
+		workers = runtime.NumCPU()
+	}
+	workers = min(workers, opts.Iterations)
+
+	total := newTally()
+	var mu sync.Mutex
+	var firstErr error
+	var wg sync.WaitGroup
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+
+			local := newTally()
+			for i := w; i < opts.Iterations; i += workers {
+				// Each iteration has its own stream so the result does not depend on how work is split
+				rng := rand.New(rand.NewPCG(opts.Seed, uint64(i)))
+				if err := simulateSeason(sched, opts.Model, rng, local); err != nil {
+					mu.Lock()
+					if firstErr == nil {
+						firstErr = err
+					}
+					mu.Unlock()
+					return
+				}
+			}
+
+			mu.Lock()
+			total.merge(local)
+			mu.Unlock()
+		}(w)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return Result{}, firstErr
+	}
+
+	return total.result(opts.Iterations), nil
+}
+
+func (t *tally) result(iterations int) Result {
+	n := float64(iterations)
+	share := func(counts []int) []float64 {
+		out := make([]float64, len(counts))
+		for i, count := range counts {
+			out[i] = float64(count) / n
+		}
+		return out
+	}
+
+	teams := make(map[string]Odds)
+	for _, tm := range team.NFLTeams {
+		teams[tm.Name] = Odds{
+			Team:          tm,
+			Playoffs:      float64(t.playoffs[tm.Name]) / n,
+			Division:      float64(t.division[tm.Name]) / n,
+			FirstRoundBye: float64(t.bye[tm.Name]) / n,
+			Seeds:         share(t.seeds[tm.Name]),
+			DraftSlots:    share(t.draftSlots[tm.Name]),
+		}
+	}
+
+	return Result{
+		Iterations: iterations,
+		Teams:      teams,
+	}
+}
+
+// simulateSeason plays out one possible finish to the season and adds its outcome to the tally
+func simulateSeason(sched schedule.Schedule, model WinModel, rng *rand.Rand, t *tally) error {
+	season := sched.Clone()
+	for _, sg := range sched.RemainingGames() {
+		outcome := standings.AwayWin
+		if rng.Float64() < model.HomeWinProbability(sg.Game) {
+			outcome = standings.HomeWin
+		}
+		if err := season.Play(sg.Week, outcome.Result(sg.Game)); err != nil {
+			return err
+		}
+	}
+
+	st, err := standings.Rank(season)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range st.Entries {
+		seed := e.Stats.Seed
+		if seed >= 1 && seed <= playoff.SeedsPerConference {
+			t.playoffs[e.Team.Name]++
+			t.seeds[e.Team.Name][seed-1]++
+		}
+		if seed >= 1 && seed <= standings.FirstRoundByes {
+			t.bye[e.Team.Name]++
+		}
+		if e.Stats.DivisionRank == 1 {
+			t.division[e.Team.Name]++
+		}
+	}
+
+	bracket, err := playoff.NewBracket(0, st.Seeded())
+	if err != nil {
+		return err
+	}
+	if err := simulatePlayoffs(bracket, model, rng); err != nil {
+		return err
+	}
+
+	order, err := draft.Order(st.Entries, st.TeamSchedules, bracket.Exits())
+	if err != nil {
+		return err
+	}
+	for i, e := range order {
+		t.draftSlots[e.Team.Name][i]++
+	}
+
+	return nil
+}
+
+// simulatePlayoffs plays every round of the bracket until a champion is decided
+func simulatePlayoffs(b *playoff.Bracket, model WinModel, rng *rand.Rand) error {
+	for {
+		if _, ok := b.Champion(); ok {
+			return nil
+		}
+
+		// AddResult builds the next round as soon as the last game of this one is decided,
+		// so play from a copy of the current round's matchups
+		matchups := append([]playoff.Matchup(nil), b.Matchups(b.Round())...)
+		for _, m := range matchups {
+			if m.Decided() {
+				continue
+			}
+
+			home, away := m.Home.Team.Name, m.Away.Team.Name
+			g := game.Game{Home: home, Away: away}
+			p := model.HomeWinProbability(g)
+			if m.Neutral {
+				// Average both orientations so neither team gets home field
+				p = (p + 1 - model.HomeWinProbability(game.Game{Home: away, Away: home})) / 2
+			}
+
+			g.Winner, g.Loser = away, home
+			if rng.Float64() < p {
+				g.Winner, g.Loser = home, away
+			}
+			if err := b.AddResult(g); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,215 @@
+package simulate
+
+import (
+	"math"
+	"math/rand"
+	"nfl-app/internal/elo"
+	"nfl-app/internal/game"
+	"nfl-app/internal/playoff"
+	"nfl-app/internal/schedule"
+	"nfl-app/internal/standings"
+	"nfl-app/internal/team"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// season returns a season of random pairings with the given number of weeks played and the rest still to play
+func season(seed int64, played, weeks int) schedule.Schedule {
+	r := rand.New(rand.NewSource(seed))
+	sched := schedule.NewSchedule()
+	for week := 1; week <= weeks; week++ {
+		order := r.Perm(len(team.NFLTeams))
+		for i := 0; i < len(order); i += 2 {
+			home, away := team.NFLTeams[order[i]].Name, team.NFLTeams[order[i+1]].Name
+			if week > played {
+				sched.AddRemaining(week, game.Game{Home: home, Away: away})
+				continue
+			}
+
+			g := game.Game{Home: home, Away: away, Winner: home, Loser: away, PtsWin: 10 + r.Intn(30)}
+			g.PtsLose = r.Intn(g.PtsWin)
+			if r.Intn(2) == 0 {
+				g.Winner, g.Loser = away, home
+			}
+			sched.AddGame(week, g)
+		}
+	}
+	return sched
+}
+
+func TestRunIsReproducible(t *testing.T) {
+	sched := season(1, 12, 17)
+
+	run := func(workers int) Result {
+		t.Helper()
+		result, err := Run(sched, Options{Iterations: 40, Seed: 7, Workers: workers})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return result
+	}
+
+	first := run(1)
+	if again := run(1); !reflect.DeepEqual(first, again) {
+		t.Errorf("expected the same seed to give the same result")
+	}
+	if split := run(4); !reflect.DeepEqual(first, split) {
+		t.Errorf("expected the same result with 4 workers as with 1")
+	}
+
+	if _, err := Run(sched, Options{Iterations: 0}); err == nil {
+		t.Errorf("expected an error for no iterations")
+	}
+}
+
+func TestRunOddsAddUp(t *testing.T) {
+	result, err := Run(season(2, 12, 17), Options{Iterations: 60, Seed: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const eps = 1e-9
+	for _, conf := range team.Conferences {
+		seeds := make([]float64, playoff.SeedsPerConference)
+		for _, odds := range result.Teams {
+			if odds.Team.Conference != conf {
+				continue
+			}
+
+			sum := 0.0
+			for i, p := range odds.Seeds {
+				seeds[i] += p
+				sum += p
+			}
+			if sum > 1+eps || math.Abs(sum-odds.Playoffs) > eps {
+				t.Errorf("%s: seed odds add up to %.3f, with playoff odds of %.3f", odds.Team.Name, sum, odds.Playoffs)
+			}
+			if odds.Division > odds.Playoffs+eps || odds.FirstRoundBye > odds.Division+eps {
+				t.Errorf("%s: bye %.3f, division %.3f and playoff %.3f odds out of order",
+					odds.Team.Name, odds.FirstRoundBye, odds.Division, odds.Playoffs)
+			}
+		}
+		for i, p := range seeds {
+			if math.Abs(p-1) > eps {
+				t.Errorf("%s: the %d seed goes to some team with odds %.3f", conf, i+1, p)
+			}
+		}
+	}
+
+	slots := make([]float64, len(team.NFLTeams))
+	for _, odds := range result.Teams {
+		sum := 0.0
+		for i, p := range odds.DraftSlots {
+			slots[i] += p
+			sum += p
+		}
+		if math.Abs(sum-1) > eps {
+			t.Errorf("%s: draft slot odds add up to %.3f", odds.Team.Name, sum)
+		}
+	}
+	for i, p := range slots {
+		if math.Abs(p-1) > eps {
+			t.Errorf("pick %d goes to some team with odds %.3f", i+1, p)
+		}
+	}
+}
+
+func TestRunByes(t *testing.T) {
+	defer func(byes int) { standings.FirstRoundByes = byes }(standings.FirstRoundByes)
+	standings.FirstRoundByes = 2
+
+	result, err := Run(season(4, 12, 17), Options{Iterations: 30, Seed: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const eps = 1e-9
+	for _, conf := range team.Conferences {
+		byes := 0.0
+		for _, odds := range result.Teams {
+			if odds.Team.Conference != conf {
+				continue
+			}
+			byes += odds.FirstRoundBye
+			if want := odds.Seeds[0] + odds.Seeds[1]; math.Abs(odds.FirstRoundBye-want) > eps {
+				t.Errorf("%s: expected bye odds of %.3f, the 1 and 2 seeds together, got %.3f",
+					odds.Team.Name, want, odds.FirstRoundBye)
+			}
+		}
+		if math.Abs(byes-2) > eps {
+			t.Errorf("%s: expected two byes a season, got bye odds adding up to %.3f", conf, byes)
+		}
+	}
+}
+
+// favorites has the team listed first in team.NFLTeams win every game
+type favorites struct{}
+
+func (favorites) HomeWinProbability(g game.Game) float64 {
+	home := slices.IndexFunc(team.NFLTeams, func(t team.Team) bool { return t.Name == g.Home })
+	away := slices.IndexFunc(team.NFLTeams, func(t team.Team) bool { return t.Name == g.Away })
+	if home < away {
+		return 1
+	}
+	return 0
+}
+
+func TestRunCertainModel(t *testing.T) {
+	sched := season(3, 12, 17)
+	result, err := Run(sched, Options{Iterations: 5, Seed: 1, Model: favorites{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Play the same results by hand
+	played := sched.Clone()
+	for _, sg := range sched.RemainingGames() {
+		outcome := standings.AwayWin
+		if (favorites{}).HomeWinProbability(sg.Game) == 1 {
+			outcome = standings.HomeWin
+		}
+		if err := played.Play(sg.Week, outcome.Result(sg.Game)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	st, err := standings.Rank(played)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, e := range st.Entries {
+		odds := result.Teams[e.Team.Name]
+		for i, p := range odds.Seeds {
+			want := 0.0
+			if i+1 == e.Stats.Seed {
+				want = 1
+			}
+			if p != want {
+				t.Errorf("%s: expected odds of %.0f for the %d seed, got %.3f", e.Team.Name, want, i+1, p)
+			}
+		}
+		for i, p := range odds.DraftSlots {
+			if p != 0 && p != 1 {
+				t.Errorf("%s: expected certain draft slots, got odds of %.3f for pick %d", e.Team.Name, p, i+1)
+			}
+		}
+	}
+}
+
+func TestEloModel(t *testing.T) {
+	home, away := team.NFLTeams[0].Name, team.NFLTeams[1].Name
+	m := NewEloModel(elo.Ratings{home: 1600})
+	opts := elo.DefaultOptions()
+
+	g := game.Game{Home: home, Away: away}
+	if got, want := m.HomeWinProbability(g), elo.ExpectedHome(1600, opts.Initial, opts); got != want {
+		t.Errorf("expected %.3f, the unrated team starting at %.0f, got %.3f", want, opts.Initial, got)
+	}
+
+	// Between even teams only home field counts
+	even := game.Game{Home: away, Away: team.NFLTeams[2].Name}
+	if p := m.HomeWinProbability(even); p <= 0.5 || p != elo.ExpectedHome(opts.Initial, opts.Initial, opts) {
+		t.Errorf("expected home field to favor the home team, got %.3f", p)
+	}
+}
@@ -0,0 +1,709 @@
+package standings
+
+import (
+	"fmt"
+	"maps"
+	"nfl-app/internal/entry"
+	"nfl-app/internal/entrysort"
+	"nfl-app/internal/schedule"
+	"nfl-app/internal/stats"
+	"nfl-app/internal/team"
+	"slices"
+)
+
+const (
+	// PlayoffTeams is the number of teams from each conference that make the playoffs
+	PlayoffTeams = 7
+
+	// WildCards is the number of playoff spots per conference not reserved for division winners
+	WildCards = PlayoffTeams - 4
+)
+
+// FirstRoundByes is the number of teams from each conference that skip the wild card round
+// It was two before 2020, when six teams from each conference made the playoffs. With one, a clinched bye always
+// comes with home field throughout the playoffs and is reported as stats.ClinchedHomeField rather than
+// stats.ClinchedFirstRoundBye
+var FirstRoundByes = 1
+
+// MaxClinchLeaves is the most outcomes of the remaining games seeded with the full tiebreaking procedure while
+// settling whether a single team has clinched something, or been eliminated. Anything still unsettled after that
+// many is not claimed, so hitting the limit reads the same as not having clinched: the indicator is weaker, never
+// wrong
+var MaxClinchLeaves = 128
+
+// maxClinchNodes bounds the partial outcomes checked against win percentage bounds for a single question. Running
+// out is treated like MaxClinchLeaves
+const maxClinchNodes = 1 << 16
+
+// clinchStatus is what a team is guaranteed (or can no longer reach) whatever the results of the remaining games
+type clinchStatus struct {
+	berth      bool
+	division   bool
+	bye        bool
+	firstSeed  bool
+	eliminated bool
+}
+
+// Clinchers returns the clinch indicator for every team, computed against the remaining games in the schedule
+// Indicators are only given once proven. A proof cut short by MaxClinchLeaves leaves the weaker indicator
+func Clinchers(sched schedule.Schedule) (map[string]string, error) {
+	st, err := Rank(sched)
+	if err != nil {
+		return nil, err
+	}
+	return clinchers(sched, st)
+}
+
+// GoalFirstRoundBye is a bye through the wild card round. A Claim can also be made for GoalDivision and
+// GoalPlayoffBerth
+const GoalFirstRoundBye = "first-round bye"
+
+// Claim is the statement that a team reaches a goal whatever the results of the remaining games. It can be checked
+// with outcomes picked for some of those games, which is much cheaper than playing them and starting over
+// As with the clinch indicators, a goal that rests on a tiebreaker the remaining games could still change is not
+// reached. A Claim is not safe for concurrent use
+type Claim struct {
+	s *clinchSearch
+	c claim
+}
+
+// NewClaim returns the claim that the team reaches the goal, against the remaining games in the schedule
+func NewClaim(sched schedule.Schedule, teamname, goalname string) (*Claim, error) {
+	goals := map[string]goal{GoalPlayoffBerth: goalBerth, GoalDivision: goalDivision, GoalFirstRoundBye: goalBye}
+	g, ok := goals[goalname]
+	if !ok {
+		return nil, fmt.Errorf("unknown goal %q", goalname)
+	}
+
+	// The search seeds what it needs itself, so the standings are left unranked
+	s := newClinchSearch(sched, unranked(sched))
+	t, ok := s.index[teamname]
+	if !ok {
+		return nil, fmt.Errorf("unknown team %q", teamname)
+	}
+	return &Claim{s: s, c: claim{team: t, goal: g}}, nil
+}
+
+// Contenders returns the team and every rival it is ranked with for the goal, its division for GoalDivision and its
+// conference otherwise, that can still finish either side of it on win percentage. Every other team finishes ahead of
+// it or behind it whatever happens, so only the contenders' remaining games can decide whether it reaches the goal
+func (c *Claim) Contenders() []string {
+	out := []string{c.s.teams[c.c.team].Name}
+	for i, t := range c.s.teams {
+		if c.s.open(c.c, i) {
+			out = append(out, t.Name)
+		}
+	}
+	return out
+}
+
+// Holds reports whether the claim holds with the outcomes picked for the given remaining games, whatever the
+// results of the rest
+func (c *Claim) Holds(games []schedule.ScheduledGame, outcomes []Outcome) (bool, error) {
+	picked := make([]int, 0, len(games))
+	defer func() {
+		for j, i := range picked {
+			c.s.unpick(i, outcomes[j])
+		}
+	}()
+
+	for j, sg := range games {
+		i := slices.IndexFunc(c.s.remaining, func(r schedule.ScheduledGame) bool {
+			return r.Week == sg.Week && r.Game.Home == sg.Game.Home && r.Game.Away == sg.Game.Away
+		})
+		if i < 0 || c.s.picked[i] != unpicked {
+			return false, fmt.Errorf("%s at %s in week %d is not a remaining game", sg.Game.Away, sg.Game.Home, sg.Week)
+		}
+		c.s.pick(i, outcomes[j])
+		picked = append(picked, i)
+	}
+	return c.s.holds(c.c)
+}
+
+func annotateClinchers(sched schedule.Schedule, st Standings) error {
+	indicators, err := clinchers(sched, st)
+	if err != nil {
+		return err
+	}
+
+	for i := range st.Entries {
+		st.Entries[i].Stats.Clincher = indicators[st.Entries[i].Team.Name]
+	}
+	return nil
+}
+
+func clinchers(sched schedule.Schedule, st Standings) (map[string]string, error) {
+	s := newClinchSearch(sched, st)
+
+	out := make(map[string]string)
+	for _, t := range team.NFLTeams {
+		status, err := s.status(t.Name)
+		if err != nil {
+			return nil, err
+		}
+		out[t.Name] = status.indicator()
+	}
+	return out, nil
+}
+
+// indicator returns the strongest clinch indicator for the status
+func (c clinchStatus) indicator() string {
+	switch {
+	case c.firstSeed:
+		return stats.ClinchedHomeField
+	case c.bye:
+		return stats.ClinchedFirstRoundBye
+	case c.division:
+		return stats.ClinchedDivision
+	case c.berth:
+		return stats.ClinchedPlayoffBerth
+	case c.eliminated:
+		return stats.Eliminated
+	}
+	return ""
+}
+
+// goal is a place in the standings a team can clinch
+type goal int
+
+const (
+	goalBerth goal = iota
+	goalDivision
+	goalBye
+	goalFirstSeed
+)
+
+// claim is a statement about a team, by its index in the search, that holds if it is true whatever the results of
+// the remaining games. The team reaches the goal in every outcome, or with never set, in none
+type claim struct {
+	team  int
+	goal  goal
+	never bool
+}
+
+// tally is a won-lost-tied record that can be changed in place as outcomes are tried
+type tally struct {
+	wins, losses, ties int
+}
+
+// percentage is stats.Record.WinPercentage, worked out the same way so the two compare equal
+func percentage(wins, losses, ties int) float64 {
+	total := float64(wins + losses + ties)
+	if total == 0 {
+		return 0
+	}
+	return (float64(wins) + float64(ties)/2) / total
+}
+
+// clinchSearch settles what each team has clinched by looking for an outcome of the remaining games in which the
+// claim fails. Only games involving the team or a rival that can still finish level with or ahead of it are played
+// out, since no other game can move a team past it. Partial outcomes are checked against the best and worst win
+// percentage every team can finish with, which proves a claim one way or the other for every outcome below it.
+// Outcomes the bounds leave open are seeded with the full tiebreaking procedure, and count as a failure of the claim
+// if a tiebreaker that involves the team's win percentage depends on anything the outcome does not fix
+//
+// Teams are indexed in the order of the base entries, and divisions in the order of team.Divisions
+type clinchSearch struct {
+	base      Standings
+	remaining []schedule.ScheduledGame
+
+	teams    []team.Team
+	index    map[string]int
+	division []int
+	tally    []tally
+	left     []int
+	games    [][2]int
+	picked   []int
+
+	// canCatch and alwaysAhead are counts by division reused by bound
+	canCatch, alwaysAhead []int
+
+	// nodes and ranked count the work done on the current question
+	nodes, ranked int
+}
+
+// unpicked marks a remaining game whose outcome has not been picked
+const unpicked = -1
+
+func newClinchSearch(sched schedule.Schedule, st Standings) *clinchSearch {
+	s := &clinchSearch{
+		base:        st,
+		remaining:   sched.RemainingGames(),
+		index:       make(map[string]int),
+		canCatch:    make([]int, len(team.Divisions)),
+		alwaysAhead: make([]int, len(team.Divisions)),
+	}
+	for i, e := range st.Entries {
+		r := e.Stats.Record
+		s.teams = append(s.teams, e.Team)
+		s.index[e.Team.Name] = i
+		s.division = append(s.division, slices.Index(team.Divisions, e.Team.Division))
+		s.tally = append(s.tally, tally{wins: r.Wins(), losses: r.Losses(), ties: r.Ties()})
+	}
+
+	s.left = make([]int, len(s.teams))
+	s.picked = make([]int, len(s.remaining))
+	for i, sg := range s.remaining {
+		home, away := s.index[sg.Game.Home], s.index[sg.Game.Away]
+		s.games = append(s.games, [2]int{home, away})
+		s.picked[i] = unpicked
+		s.left[home]++
+		s.left[away]++
+	}
+	return s
+}
+
+// status settles every claim that makes up a clinch indicator for the team
+func (s *clinchSearch) status(teamname string) (clinchStatus, error) {
+	var c clinchStatus
+	var err error
+
+	t, ok := s.index[teamname]
+	if !ok {
+		return c, nil
+	}
+	if c.division, err = s.holds(claim{team: t, goal: goalDivision}); err != nil {
+		return c, err
+	}
+	c.berth = c.division
+	if !c.berth {
+		if c.berth, err = s.holds(claim{team: t, goal: goalBerth}); err != nil {
+			return c, err
+		}
+	}
+	if !c.berth {
+		c.eliminated, err = s.holds(claim{team: t, goal: goalBerth, never: true})
+		return c, err
+	}
+
+	if c.division {
+		if c.bye, err = s.holds(claim{team: t, goal: goalBye}); err != nil {
+			return c, err
+		}
+	}
+	if c.bye {
+		c.firstSeed = FirstRoundByes == 1
+		if !c.firstSeed {
+			if c.firstSeed, err = s.holds(claim{team: t, goal: goalFirstSeed}); err != nil {
+				return c, err
+			}
+		}
+	}
+	return c, nil
+}
+
+// holds reports whether the claim is true whatever the results of the remaining games
+// A claim that could not be settled within MaxClinchLeaves seeded outcomes does not hold
+func (s *clinchSearch) holds(c claim) (bool, error) {
+	s.nodes, s.ranked = 0, 0
+	found, err := s.counterexample(c, s.relevant(c), 0)
+	return !found, err
+}
+
+// relevant returns the remaining games without an outcome, by index, that can move a rival past the claim's team or
+// the other way around: the team's own games, then those of every rival that can still finish level with or ahead of it, with
+// games between two rivals last. For a division, only division rivals count
+//
+// The search tries other outcomes for the last games first, and which of two rivals wins a game between them is
+// what most often decides whether enough of them get past the team
+func (s *clinchSearch) relevant(c claim) []int {
+	worst := s.worst(c.team)
+	rivals := make([]bool, len(s.teams))
+	for i := range s.teams {
+		rivals[i] = i == c.team || (s.inScope(c, i) && s.best(i) >= worst)
+	}
+
+	var own, others, between []int
+	for i, g := range s.games {
+		home, away := g[0], g[1]
+		switch {
+		case s.picked[i] != unpicked:
+			continue
+		case home == c.team || away == c.team:
+			own = append(own, i)
+		case rivals[home] && rivals[away]:
+			between = append(between, i)
+		case rivals[home] || rivals[away]:
+			others = append(others, i)
+		}
+	}
+	return slices.Concat(own, others, between)
+}
+
+// counterexample looks for outcomes of the games from the i'th on in which the claim fails
+// Giving up counts as finding one, so that nothing unsettled is claimed
+func (s *clinchSearch) counterexample(c claim, games []int, i int) (bool, error) {
+	s.nodes++
+	if s.nodes > maxClinchNodes {
+		return true, nil
+	}
+
+	reached, missed := s.bound(c.team, c.goal)
+	if c.never {
+		reached, missed = missed, reached
+	}
+	if reached {
+		return false, nil
+	}
+	if missed {
+		return true, nil
+	}
+
+	// Skip games that can no longer move anyone past the team or the other way around
+	for i < len(games) && !s.matters(c, games[i]) {
+		i++
+	}
+	if i == len(games) {
+		reached, exact, err := s.judge(c)
+		return err == nil && (!exact || reached == c.never), err
+	}
+
+	for _, o := range s.order(c, games[i]) {
+		s.pick(games[i], o)
+		found, err := s.counterexample(c, games, i+1)
+		s.unpick(games[i], o)
+		if err != nil || found {
+			return found, err
+		}
+	}
+	return false, nil
+}
+
+// matters reports whether the game involves the claim's team, or a rival that can still finish either side of it
+// Once a team is sure to finish behind the claim's team, or sure to finish ahead, its other games only change its
+// own record, and so can't change how many teams finish ahead
+func (s *clinchSearch) matters(c claim, i int) bool {
+	home, away := s.games[i][0], s.games[i][1]
+	if home == c.team || away == c.team {
+		return true
+	}
+	return s.open(c, home) || s.open(c, away)
+}
+
+// open reports whether the team is a rival of the claim's team that can still finish either side of it
+func (s *clinchSearch) open(c claim, other int) bool {
+	if other == c.team || !s.inScope(c, other) {
+		return false
+	}
+	return s.best(other) >= s.worst(c.team) && s.worst(other) <= s.best(c.team)
+}
+
+// order returns the outcomes of the game, the ones most likely to break the claim first: the team losing and
+// its rivals winning when the claim is that it reaches the goal, and the other way around when it is that it never
+// does. Ties are rare, so they come last
+func (s *clinchSearch) order(c claim, i int) []Outcome {
+	homeFirst := []Outcome{HomeWin, AwayWin, Tie}
+	awayFirst := []Outcome{AwayWin, HomeWin, Tie}
+
+	// Whether the claim's team wanting the home team to win makes it more likely to hold
+	home, away := s.games[i][0], s.games[i][1]
+	var wantHome bool
+	switch {
+	case home == c.team:
+		wantHome = true
+	case away == c.team:
+		wantHome = false
+	case s.open(c, home) && s.open(c, away):
+		// Between two rivals, the team is better off with the win going to the one that can afford it, the one
+		// that finishes further ahead even losing the rest
+		wantHome = s.worst(home) >= s.worst(away)
+	default:
+		// A rival is better off losing
+		wantHome = !s.open(c, home)
+	}
+	if wantHome == c.never {
+		return homeFirst
+	}
+	return awayFirst
+}
+
+func (s *clinchSearch) pick(i int, o Outcome) {
+	s.picked[i] = int(o)
+	s.record(i, o, 1)
+}
+
+func (s *clinchSearch) unpick(i int, o Outcome) {
+	s.picked[i] = unpicked
+	s.record(i, o, -1)
+}
+
+// record adds (or with n of -1, takes back) the outcome of the i'th remaining game to both teams' tallies
+func (s *clinchSearch) record(i int, o Outcome, n int) {
+	h, a := s.games[i][0], s.games[i][1]
+	home, away := &s.tally[h], &s.tally[a]
+	switch o {
+	case HomeWin:
+		home.wins += n
+		away.losses += n
+	case AwayWin:
+		away.wins += n
+		home.losses += n
+	default:
+		home.ties += n
+		away.ties += n
+	}
+	s.left[h] -= n
+	s.left[a] -= n
+}
+
+func (s *clinchSearch) pct(i int) float64 {
+	t := s.tally[i]
+	return percentage(t.wins, t.losses, t.ties)
+}
+
+// best is the win percentage the team finishes with if it wins every game left
+func (s *clinchSearch) best(i int) float64 {
+	t := s.tally[i]
+	return percentage(t.wins+s.left[i], t.losses, t.ties)
+}
+
+// worst is the win percentage the team finishes with if it loses every game left
+func (s *clinchSearch) worst(i int) float64 {
+	t := s.tally[i]
+	return percentage(t.wins, t.losses+s.left[i], t.ties)
+}
+
+// bound decides the goal from the best and worst win percentage every team can finish with, if it can be decided
+// that way. reached is true when the team reaches the goal even losing every tiebreaker against teams it can
+// finish level with, missed when it misses the goal even winning every one
+func (s *clinchSearch) bound(t int, g goal) (reached, missed bool) {
+	best, worst := s.best(t), s.worst(t)
+
+	// Teams that could finish level with or ahead of this team, and teams that will finish ahead of it no matter
+	// what, counted by division
+	canCatch, alwaysAhead := s.canCatch, s.alwaysAhead
+	clear(canCatch)
+	clear(alwaysAhead)
+	for other := range s.teams {
+		if other == t || s.teams[other].Conference != s.teams[t].Conference {
+			continue
+		}
+		if s.best(other) >= worst {
+			canCatch[s.division[other]]++
+		}
+		if s.worst(other) > best {
+			alwaysAhead[s.division[other]]++
+		}
+	}
+	own := s.division[t]
+	division := canCatch[own] == 0
+	noDivision := alwaysAhead[own] > 0
+
+	switch g {
+	case goalDivision:
+		return division, noDivision
+
+	case goalBerth:
+		// At most three teams from another division can be wild cards, since one of them must win the division.
+		// In this team's own division, the division winner is one of the teams that caught it. Missed if it can
+		// no longer win the division, and enough teams are guaranteed to finish ahead of it without winning their
+		// own division to take every wild card
+		wildCardsAhead, guaranteedWildCards := 0, 0
+		for div := range canCatch {
+			if div == own {
+				wildCardsAhead += max(canCatch[div]-1, 0)
+			} else {
+				wildCardsAhead += min(canCatch[div], 3)
+			}
+			guaranteedWildCards += max(alwaysAhead[div]-1, 0)
+		}
+		return division || wildCardsAhead < WildCards, noDivision && guaranteedWildCards >= WildCards
+	}
+
+	// The top seeds all go to division winners. Every other division with a team that can catch this one may
+	// have its winner seeded ahead of it, and every one with a team that is always ahead does
+	seeds := s.seeds(g)
+	caught, ahead := 0, 0
+	for div := range canCatch {
+		if div == own {
+			continue
+		}
+		if canCatch[div] > 0 {
+			caught++
+		}
+		if alwaysAhead[div] > 0 {
+			ahead++
+		}
+	}
+	return division && caught < seeds, noDivision || ahead >= seeds
+}
+
+// seeds is the number of top seeds that make up a bye or first seed goal
+func (s *clinchSearch) seeds(g goal) int {
+	if g == goalBye {
+		return FirstRoundByes
+	}
+	return 1
+}
+
+// reached reports whether a team with the given division rank and seed reaches the goal
+func (s *clinchSearch) reached(g goal, divisionRank, seed int) bool {
+	switch g {
+	case goalDivision:
+		return divisionRank == 1
+	case goalBerth:
+		return seed <= PlayoffTeams
+	}
+	return seed <= s.seeds(g)
+}
+
+// inScope reports whether the other team is ranked along with the claim's team: its division for a division
+// claim, its conference otherwise
+func (s *clinchSearch) inScope(c claim, other int) bool {
+	if c.goal == goalDivision {
+		return s.division[other] == s.division[c.team]
+	}
+	return s.teams[other].Conference == s.teams[c.team].Conference
+}
+
+// judge decides the claim's goal once every relevant game has an outcome. exact is false when
+// the result rests on a tiebreaker the outcome does not fix
+//
+// Every team that can finish level with or ahead of the claim's team has all of its games picked, and every other
+// team is behind it whatever the rest of its games, so win percentages place everyone but the teams level with it.
+// Those are broken with the full tiebreaking procedure, as sorting the division or conference would
+func (s *clinchSearch) judge(c claim) (reached, exact bool, err error) {
+	pct := s.pct(c.team)
+
+	// Teams ahead of the claim's team on win percentage, counted by division, and the teams level with it
+	ahead := make([]int, len(team.Divisions))
+	level := []int{c.team}
+	for other := range s.teams {
+		if other == c.team || !s.inScope(c, other) {
+			continue
+		}
+		switch p := s.pct(other); {
+		case p > pct:
+			ahead[s.division[other]]++
+		case p == pct:
+			level = append(level, other)
+		}
+	}
+
+	// Placed first or last among the level teams, the team may reach the goal either way, or miss it either way,
+	// and then the tiebreakers don't matter
+	if first, last := s.placed(c, ahead, nil), s.placed(c, ahead, level[1:]); first == last {
+		return first, true, nil
+	}
+
+	s.ranked++
+	if s.ranked > MaxClinchLeaves {
+		return false, false, nil
+	}
+	level, exact, err = s.breakLevel(c, level)
+	if err != nil {
+		return false, false, err
+	}
+	return s.placed(c, ahead, level[:slices.Index(level, c.team)]), exact, nil
+}
+
+// placed reports whether the claim's team reaches the goal with the given teams ahead of it on win percentage,
+// counted by division, and the given level teams placed ahead of it by tiebreakers
+func (s *clinchSearch) placed(c claim, ahead []int, levelAhead []int) bool {
+	ahead = slices.Clone(ahead)
+
+	// The first team of a division placed ahead is its winner
+	winnersAhead, teamsAhead := 0, 0
+	for _, n := range ahead {
+		if n > 0 {
+			winnersAhead++
+		}
+		teamsAhead += n
+	}
+	for _, other := range levelAhead {
+		div := s.division[other]
+		if ahead[div] == 0 {
+			winnersAhead++
+		}
+		ahead[div]++
+		teamsAhead++
+	}
+
+	if ahead[s.division[c.team]] > 0 {
+		// A wild card, seeded after every division winner and every other team ahead of it
+		seed := 1 + len(team.ConferenceDivisions(s.teams[c.team].Conference)) + teamsAhead - winnersAhead
+		return s.reached(c.goal, 2, seed)
+	}
+	return s.reached(c.goal, 1, 1+winnersAhead)
+}
+
+// breakLevel orders the teams level on win percentage with the claim's team. exact is false when a tiebreaker
+// applied before the team's place was settled depends on something the picked outcomes do not fix: the strength of
+// victory and schedule until every remaining game has an outcome, and points (and coin tosses) until every game has
+// been played for real. Tiebreakers that only order teams it already finishes ahead of or behind don't count
+func (s *clinchSearch) breakLevel(c claim, level []int) ([]int, bool, error) {
+	entries, ts, complete, err := s.leaf(level)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var group, levelEntries []entry.Entry
+	for i, e := range entries {
+		if s.inScope(c, i) {
+			group = append(group, e)
+		}
+		if slices.Contains(level, i) {
+			levelEntries = append(levelEntries, e)
+		}
+	}
+	sorted, applied, err := entrysort.SortLevelFor(levelEntries, group, ts, s.teams[c.team].Name)
+	if err != nil {
+		return nil, false, err
+	}
+
+	exact := true
+	for _, name := range applied {
+		switch entrysort.TiebreakerBasis(name) {
+		case entrysort.OpponentRecords:
+			exact = exact && complete
+		case entrysort.Scores:
+			exact = exact && len(s.remaining) == 0
+		}
+	}
+	order := make([]int, len(sorted))
+	for i, e := range sorted {
+		order[i] = s.index[e.Team.Name]
+	}
+	return order, exact, nil
+}
+
+// leaf returns the entries and team schedules with the picked outcomes played, and whether every remaining game
+// has an outcome. Only the level teams have their strength of victory and schedule worked out again, as they are
+// the only ones sorted. Points ranks are left as they were, since a tiebreaker on points can't settle a claim
+// while any game is left to play
+func (s *clinchSearch) leaf(level []int) ([]entry.Entry, map[string]schedule.Schedule, bool, error) {
+	entries := slices.Clone(s.base.Entries)
+	ts := maps.Clone(s.base.TeamSchedules)
+
+	complete := true
+	copied := make([]bool, len(s.teams))
+	for i, o := range s.picked {
+		if o == unpicked {
+			complete = false
+			continue
+		}
+		sg := s.remaining[i]
+		result := Outcome(o).Result(sg.Game)
+		for _, t := range s.games[i] {
+			// Team schedules are shared with the base standings, so copy the weeks before changing them
+			name := s.teams[t].Name
+			teamSched := ts[name]
+			if !copied[t] {
+				teamSched.Weeks = slices.Clone(teamSched.Weeks)
+				copied[t] = true
+			}
+			if err := teamSched.Play(sg.Week, result); err != nil {
+				return nil, nil, false, err
+			}
+			ts[name] = teamSched
+			entries[t].AddGame(result)
+		}
+	}
+
+	byTeam := entry.ByTeam(entries)
+	for _, t := range level {
+		st := &entries[t].Stats
+		st.StrengthOfVictory, st.StrengthOfSchedule = schedule.Strengths(s.teams[t].Name, byTeam, ts)
+	}
+	return entries, ts, complete, nil
+}
@@ -0,0 +1,365 @@
+package standings
+
+import (
+	"math/rand"
+	"nfl-app/internal/game"
+	"nfl-app/internal/schedule"
+	"nfl-app/internal/scraper"
+	"nfl-app/internal/stats"
+	"nfl-app/internal/team"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+// season builds a schedule by hand, handing out NFC teams as opponents for AFC teams' games that only count
+// towards their record
+type season struct {
+	sched schedule.Schedule
+	nfc   map[int]int
+}
+
+func newSeason() *season {
+	return &season{sched: schedule.NewSchedule(), nfc: make(map[int]int)}
+}
+
+func (s *season) opponent(week int) string {
+	nfc := teamsIn(team.NFCEast, team.NFCNorth, team.NFCSouth, team.NFCWest)
+	s.nfc[week]++
+	return nfc[s.nfc[week]-1]
+}
+
+func (s *season) beat(week int, winner, loser string) {
+	s.sched.AddGame(week, game.Game{Winner: winner, Loser: loser, Home: winner, Away: loser, PtsWin: 20, PtsLose: 10})
+}
+
+// win and lose play an AFC team against the next free NFC team of the week
+func (s *season) win(week int, name string)  { s.beat(week, name, s.opponent(week)) }
+func (s *season) lose(week int, name string) { s.beat(week, s.opponent(week), name) }
+
+// tie plays an AFC team to a tie at home against the next free NFC team of the week
+func (s *season) tie(week int, name string) {
+	s.sched.AddGame(week, game.Game{Home: name, Away: s.opponent(week), PtsWin: 10, PtsLose: 10})
+}
+
+func (s *season) remaining(week int, name string) {
+	s.sched.AddRemaining(week, game.Game{Home: name, Away: s.opponent(week)})
+}
+
+func teamsIn(divisions ...string) []string {
+	var names []string
+	for _, div := range divisions {
+		for _, t := range team.NFLTeams {
+			if t.Division == div {
+				names = append(names, t.Name)
+			}
+		}
+	}
+	return names
+}
+
+// season2023 returns the 2023 regular season from the golden snapshot as it stood after the given week
+func season2023(t *testing.T, week int) schedule.Schedule {
+	t.Helper()
+	sched := snapshot(t, 2023)
+	return sched.Through(week)
+}
+
+// snapshot returns a regular season from the golden snapshots
+func snapshot(t *testing.T, season int) schedule.Schedule {
+	t.Helper()
+	f, err := os.Open(filepath.Join("..", "golden", "testdata", "seasons", strconv.Itoa(season), "games.csv"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	rows, err := scraper.ReadCSV(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	regular, _ := scraper.SplitPlayoffs(rows)
+	return schedule.CreateSchedule(regular)
+}
+
+func clinchersOf(t *testing.T, sched schedule.Schedule) map[string]string {
+	t.Helper()
+	indicators, err := Clinchers(sched)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return indicators
+}
+
+func holds(t *testing.T, sched schedule.Schedule, name string, g goal, never bool) bool {
+	t.Helper()
+	st, err := Rank(sched)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := newClinchSearch(sched, st)
+	ok, err := s.holds(claim{team: s.index[name], goal: g, never: never})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ok
+}
+
+func TestClinchDivisionOnHeadToHead(t *testing.T) {
+	east, north := teamsIn(team.AFCEast), teamsIn(team.AFCNorth)
+	a, b, e := east[0], east[1], north[0]
+
+	// a swept b, and b can at best finish level with it, 3-2 to 3-2
+	s := newSeason()
+	s.beat(1, a, b)
+	s.beat(2, a, b)
+	s.win(3, a)
+	s.lose(4, a)
+	s.win(3, b)
+	s.win(4, b)
+	s.remaining(5, a)
+	s.remaining(5, b)
+	// e can finish ahead of a, so a has clinched nothing beyond its division
+	for week := 1; week <= 3; week++ {
+		s.win(week, e)
+	}
+	s.lose(4, e)
+	s.remaining(5, e)
+
+	indicators := clinchersOf(t, s.sched)
+	if indicators[a] != stats.ClinchedDivision || indicators[b] != stats.ClinchedPlayoffBerth {
+		t.Errorf("expected %s to clinch the division and %s a berth, got %q and %q", a, b, indicators[a], indicators[b])
+	}
+	if !holds(t, s.sched, b, goalDivision, true) {
+		t.Errorf("expected %s to be out of the division race", b)
+	}
+
+	// With two byes, only e's division can get ahead of a
+	defer func(byes int) { FirstRoundByes = byes }(FirstRoundByes)
+	FirstRoundByes = 2
+	if got := clinchersOf(t, s.sched)[a]; got != stats.ClinchedFirstRoundBye {
+		t.Errorf("expected %s to clinch a bye with two of them, got %q", a, got)
+	}
+}
+
+func TestNoClinchLosingHeadToHead(t *testing.T) {
+	east := teamsIn(team.AFCEast)
+	a, b := east[0], east[1]
+
+	// b swept a, and can finish level with it, 3-3 to 3-3
+	s := newSeason()
+	s.beat(1, b, a)
+	s.beat(2, b, a)
+	for week := 3; week <= 5; week++ {
+		s.win(week, a)
+		s.lose(week, b)
+	}
+	s.remaining(6, a)
+	s.remaining(6, b)
+
+	if got := clinchersOf(t, s.sched)[a]; got != stats.ClinchedPlayoffBerth {
+		t.Errorf("expected %s to clinch only a berth, got %q", a, got)
+	}
+	if holds(t, s.sched, b, goalDivision, true) {
+		t.Errorf("expected %s to still be in the division race", b)
+	}
+}
+
+func TestEliminationOnConferenceRecord(t *testing.T) {
+	east, north, south, west := teamsIn(team.AFCEast), teamsIn(team.AFCNorth), teamsIn(team.AFCSouth), teamsIn(team.AFCWest)
+	leaders := []string{east[0], north[0], south[0], west[0], north[1], south[1]}
+	tm, rival, other := east[1], west[1], north[2]
+
+	tests := []struct {
+		name string
+		// tmBeatsOther is whether the team's AFC game is a win, rather than a loss to a leader. The rival's AFC game is
+		// the other way around
+		tmBeatsOther bool
+		want         string
+	}{
+		{"worse conference record", false, stats.Eliminated},
+		{"better conference record", true, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Six teams finish 4-0, and the team can at best finish 2-2, level with the rival for the last wild card
+			s := newSeason()
+			for i, leader := range leaders {
+				for week := 1; week <= 4; week++ {
+					switch {
+					case week == 1 && i == 0 && !tt.tmBeatsOther:
+						s.beat(week, leader, tm)
+					case week == 1 && i == 3 && tt.tmBeatsOther:
+						s.beat(week, leader, rival)
+					default:
+						s.win(week, leader)
+					}
+				}
+			}
+			// Either way the team is 1-2 and the rival 2-2, with one more win against NFC teams for whoever lost
+			// their AFC game
+			if tt.tmBeatsOther {
+				s.beat(1, tm, other)
+				s.lose(2, tm)
+				s.win(2, rival)
+			} else {
+				s.beat(1, rival, other)
+				s.win(2, tm)
+				s.lose(2, rival)
+			}
+			s.lose(3, tm)
+			s.remaining(4, tm)
+			s.win(3, rival)
+			s.lose(4, rival)
+
+			if got := clinchersOf(t, s.sched)[tm]; got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+// forEachOutcome plays every combination of outcomes for the given games on a copy of sched, calling fn with
+// the resulting schedule and the outcome picked for each game. The original schedule is not modified
+func forEachOutcome(sched schedule.Schedule, games []schedule.ScheduledGame, outcomes []Outcome,
+	fn func(schedule.Schedule, []Outcome) error) error {
+	picked := make([]Outcome, len(games))
+
+	var walk func(i int, cur schedule.Schedule) error
+	walk = func(i int, cur schedule.Schedule) error {
+		if i == len(games) {
+			return fn(cur, picked)
+		}
+
+		for _, o := range outcomes {
+			next := cur.Clone()
+			if err := next.Play(games[i].Week, o.Result(games[i].Game)); err != nil {
+				return err
+			}
+			picked[i] = o
+			if err := walk(i+1, next); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return walk(0, sched)
+}
+
+// TestClinchersHoldInEveryOutcome plays out every outcome of the last games of random seasons, checking each
+// clinch indicator against the seeding of every one
+func TestClinchersHoldInEveryOutcome(t *testing.T) {
+	claimed := 0
+	for seed := int64(1); seed <= 3; seed++ {
+		sched, results := randomSeason(rand.New(rand.NewSource(seed)), 17)
+		left := results[len(results)-5:]
+		for _, sg := range results[:len(results)-5] {
+			if err := sched.Play(sg.Week, sg.Game); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		indicators := clinchersOf(t, sched)
+		for _, c := range indicators {
+			if c != "" {
+				claimed++
+			}
+		}
+
+		err := forEachOutcome(sched, left, Outcomes, func(cur schedule.Schedule, picked []Outcome) error {
+			st, err := Rank(cur)
+			if err != nil {
+				return err
+			}
+			for _, e := range st.Entries {
+				rank, seed := e.Stats.DivisionRank, e.Stats.Seed
+				berth := seed >= 1 && seed <= PlayoffTeams
+				var ok bool
+				switch indicators[e.Team.Name] {
+				case stats.ClinchedHomeField:
+					ok = seed == 1
+				case stats.ClinchedFirstRoundBye:
+					ok = seed <= FirstRoundByes && rank == 1
+				case stats.ClinchedDivision:
+					ok = rank == 1
+				case stats.ClinchedPlayoffBerth:
+					ok = berth
+				case stats.Eliminated:
+					ok = !berth
+				default:
+					ok = true
+				}
+				if !ok {
+					t.Errorf("season %d, outcomes %v: %s is %q but finished with division rank %d and seed %d",
+						seed, picked, e.Team.Name, indicators[e.Team.Name], rank, seed)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if claimed == 0 {
+		t.Errorf("expected some team to clinch something")
+	}
+}
+
+// TestClinchers2023Week17 checks the clinch indicators going into the last week of 2023. San Francisco had the first
+// seed locked up, although losing while Detroit, Dallas and Philadelphia won leaves four teams level with it, and the
+// strength of victory that orders the other three can't be known until every game is played
+func TestClinchers2023Week17(t *testing.T) {
+	sched := season2023(t, 17)
+	indicators := clinchersOf(t, sched)
+
+	want := map[string]string{
+		"Baltimore Ravens":      stats.ClinchedHomeField,
+		"Miami Dolphins":        stats.ClinchedPlayoffBerth,
+		"Kansas City Chiefs":    stats.ClinchedDivision,
+		"Cleveland Browns":      stats.ClinchedPlayoffBerth,
+		"Buffalo Bills":         "",
+		"San Francisco 49ers":   stats.ClinchedHomeField,
+		"Dallas Cowboys":        stats.ClinchedPlayoffBerth,
+		"Detroit Lions":         stats.ClinchedDivision,
+		"Philadelphia Eagles":   stats.ClinchedPlayoffBerth,
+		"New York Jets":         stats.Eliminated,
+		"Las Vegas Raiders":     stats.Eliminated,
+		"Washington Commanders": stats.Eliminated,
+	}
+	for name, w := range want {
+		if got := indicators[name]; got != w {
+			t.Errorf("%s: expected %q, got %q", name, w, got)
+		}
+	}
+
+	// The outcome that used to count against San Francisco
+	for _, sg := range sched.RemainingGames() {
+		g := sg.Game
+		switch {
+		case g.Home == "San Francisco 49ers" || g.Away == "San Francisco 49ers":
+			g.Winner, g.Loser = "Los Angeles Rams", "San Francisco 49ers"
+		case g.Away == "Minnesota Vikings":
+			g.Winner, g.Loser = "Detroit Lions", "Minnesota Vikings"
+		case g.Away == "Dallas Cowboys":
+			g.Winner, g.Loser = "Dallas Cowboys", "Washington Commanders"
+		case g.Away == "Philadelphia Eagles":
+			g.Winner, g.Loser = "Philadelphia Eagles", "New York Giants"
+		default:
+			continue
+		}
+		g.PtsWin, g.PtsLose = 20, 10
+		if err := sched.Play(sg.Week, g); err != nil {
+			t.Fatal(err)
+		}
+	}
+	st, err := Rank(sched)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range st.Entries {
+		if e.Team.Name == "San Francisco 49ers" && (e.Stats.Seed != 1 || e.Stats.Record.Losses() != 5) {
+			t.Errorf("expected San Francisco to be seeded first at 12-5, got seed %d with %d losses", e.Stats.Seed, e.Stats.Record.Losses())
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package standings
+
+import (
+	"maps"
+	"nfl-app/internal/entry"
+	"nfl-app/internal/game"
+	"nfl-app/internal/schedule"
+	"slices"
+)
+
+// Engine keeps ranked standings up to date as results come in one game at a time
+// Applying a result only updates the two teams that played, the strength of victory and schedule of
+// the teams whose opponents' records changed, and re-ranks only the divisions and conferences whose order can have moved
+type Engine struct {
+	sched schedule.Schedule
+	st    Standings
+
+	// index maps a team name to its position in st.Entries
+	index map[string]int
+}
+
+// NewEngine ranks the schedule once, ready for results to be applied
+func NewEngine(sched schedule.Schedule) (*Engine, error) {
+	sched = sched.Clone()
+	st, err := Rank(sched)
+	if err != nil {
+		return nil, err
+	}
+
+	index := make(map[string]int)
+	for i, e := range st.Entries {
+		index[e.Team.Name] = i
+	}
+	return &Engine{sched: sched, st: st, index: index}, nil
+}
+
+// Apply records the result of a remaining game in the given week and updates the standings
+// The remaining game is matched on home and away team, like schedule.Play
+func (e *Engine) Apply(week int, result game.Game) error {
+	if err := e.sched.Play(week, result); err != nil {
+		return err
+	}
+	games := e.sched.Weeks[week-1].Games
+	played := games[len(games)-1]
+
+	// Team schedules are shared with standings handed out earlier, so copy the weeks before changing them
+	e.st.TeamSchedules = maps.Clone(e.st.TeamSchedules)
+	for _, name := range []string{played.Home, played.Away} {
+		ts := e.st.TeamSchedules[name]
+		ts.Weeks = slices.Clone(ts.Weeks)
+		if err := ts.Play(week, played); err != nil {
+			return err
+		}
+		e.st.TeamSchedules[name] = ts
+	}
+
+	e.st.Entries = slices.Clone(e.st.Entries)
+	changed := make(map[string]bool)
+	for _, name := range []string{played.Home, played.Away} {
+		e.st.Entries[e.index[name]].AddGame(played)
+		changed[name] = true
+	}
+
+	// The two teams' records feed into the strength of schedule of everyone who has played them
+	byTeam := entry.ByTeam(e.st.Entries)
+	for _, name := range e.strengthAffected(played) {
+		s := &e.st.Entries[e.index[name]].Stats
+		sov, sos := schedule.Strengths(name, byTeam, e.st.TeamSchedules)
+		if sov != s.StrengthOfVictory || sos != s.StrengthOfSchedule {
+			s.StrengthOfVictory, s.StrengthOfSchedule = sov, sos
+			changed[name] = true
+		}
+	}
+
+	// Points ranks are league wide but cheap, so redo them and note whose rank moved
+	before := slices.Clone(e.st.Entries)
+	schedule.RankPoints(e.st.Entries)
+	for i, b := range before {
+		a := e.st.Entries[i].Stats
+		if a.LeagueRankPointsFor != b.Stats.LeagueRankPointsFor ||
+			a.LeagueRankPointsAgainst != b.Stats.LeagueRankPointsAgainst ||
+			a.ConferenceRankPointsFor != b.Stats.ConferenceRankPointsFor ||
+			a.ConferenceRankPointsAgainst != b.Stats.ConferenceRankPointsAgainst {
+			changed[b.Team.Name] = true
+		}
+	}
+
+	// Remaining strength of schedule and the ratings are not tiebreakers, so they are simply redone for everyone
+	for i := range e.st.Entries {
+		s := &e.st.Entries[i].Stats
+		s.RemainingStrengthOfSchedule = schedule.RemainingStrength(e.st.Entries[i].Team.Name, byTeam, e.st.TeamSchedules)
+	}
+	schedule.RateEntries(e.st.Entries, e.st.TeamSchedules)
+
+	for div, entries := range entry.GroupByDivision(e.st.Entries) {
+		if needsRanking(entries, played, changed) {
+			if err := e.st.rankDivision(div, nil); err != nil {
+				return err
+			}
+		}
+	}
+	for conf, entries := range entry.GroupByConference(e.st.Entries) {
+		if needsRanking(entries, played, changed) {
+			if err := e.st.seedConference(conf, nil); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// needsRanking reports whether the order of the group can have changed. It has if one of the teams that played is
+// in it, since their win percentages moved. Other changed teams only matter when tied with someone on win percentage,
+// as their changes are to tiebreakers (a team's strength of victory and schedule depend on its opponents' records only)
+func needsRanking(group []entry.Entry, played game.Game, changed map[string]bool) bool {
+	for _, a := range group {
+		if a.Team.Name == played.Home || a.Team.Name == played.Away {
+			return true
+		}
+	}
+	for _, a := range group {
+		if !changed[a.Team.Name] {
+			continue
+		}
+		for _, b := range group {
+			if a.Team.Name != b.Team.Name && a.Stats.Record.WinPercentage() == b.Stats.Record.WinPercentage() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// strengthAffected returns the two teams in the game and every team that has played either of them
+func (e *Engine) strengthAffected(played game.Game) []string {
+	out := []string{played.Home, played.Away}
+	for _, name := range []string{played.Home, played.Away} {
+		ts := e.st.TeamSchedules[name]
+		for opp := range ts.OpponentMapFor(name) {
+			if !slices.Contains(out, opp) {
+				out = append(out, opp)
+			}
+		}
+	}
+	return out
+}
+
+// Standings returns the current standings, ranked and seeded but without clinch indicators, like Rank
+// The result is not changed by later calls to Apply
+func (e *Engine) Standings() Standings {
+	return Standings{
+		Entries:       slices.Clone(e.st.Entries),
+		TeamSchedules: e.st.TeamSchedules,
+	}
+}
+
+// Schedule returns a copy of the schedule with every result applied so far
+func (e *Engine) Schedule() schedule.Schedule {
+	return e.sched.Clone()
+}
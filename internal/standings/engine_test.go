@@ -0,0 +1,79 @@
+package standings
+
+import (
+	"math/rand"
+	"nfl-app/internal/game"
+	"nfl-app/internal/schedule"
+	"nfl-app/internal/team"
+	"testing"
+)
+
+// randomSeason returns a season of random pairings, every game still to be played, and the results to play them with
+func randomSeason(r *rand.Rand, weeks int) (schedule.Schedule, []schedule.ScheduledGame) {
+	sched := schedule.NewSchedule()
+	results := make([]schedule.ScheduledGame, 0)
+	for week := 1; week <= weeks; week++ {
+		order := r.Perm(len(team.NFLTeams))
+		for i := 0; i < len(order); i += 2 {
+			home, away := team.NFLTeams[order[i]].Name, team.NFLTeams[order[i+1]].Name
+			sched.AddRemaining(week, game.Game{Home: home, Away: away})
+
+			g := game.Game{Home: home, Away: away, Winner: home, Loser: away, PtsWin: 10 + r.Intn(30)}
+			g.PtsLose = r.Intn(g.PtsWin)
+			if r.Intn(2) == 0 {
+				g.Winner, g.Loser = away, home
+			}
+			results = append(results, schedule.ScheduledGame{Week: week, Game: g})
+		}
+	}
+	return sched, results
+}
+
+func TestEngineMatchesRank(t *testing.T) {
+	sched, results := randomSeason(rand.New(rand.NewSource(1)), 17)
+
+	engine, err := NewEngine(sched)
+	if err != nil {
+		t.Fatal(err)
+	}
+	first := engine.Standings()
+
+	for i, sg := range results {
+		if err := engine.Apply(sg.Week, sg.Game); err != nil {
+			t.Fatal(err)
+		}
+		if err := sched.Play(sg.Week, sg.Game); err != nil {
+			t.Fatal(err)
+		}
+		if i == len(results)/2 {
+			compareStats(t, engine.Standings(), sched)
+		}
+	}
+	if err := engine.Apply(results[0].Week, results[0].Game); err == nil {
+		t.Errorf("expected applying a game twice to fail")
+	}
+
+	compareStats(t, engine.Standings(), sched)
+
+	// Standings handed out before are left alone
+	for _, e := range first.Entries {
+		if e.Stats.Record.GamesPlayed() != 0 {
+			t.Fatalf("expected the first standings to be unchanged, %s has played %d games", e.Team.Name, e.Stats.Record.GamesPlayed())
+		}
+	}
+}
+
+// compareStats checks every team's stats match those of ranking the schedule from scratch
+func compareStats(t *testing.T, got Standings, sched schedule.Schedule) {
+	t.Helper()
+	want, err := Rank(sched)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, w := range want.Entries {
+		g, _ := got.Entry(w.Team.Name)
+		if g.Stats != w.Stats {
+			t.Errorf("%s: got %+v, want %+v", w.Team.Name, g.Stats, w.Stats)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package standings
+
+import (
+	"fmt"
+	"nfl-app/internal/schedule"
+	"nfl-app/internal/stats"
+)
+
+// StandingsAsOf computes the standings, clinch indicators included, as they stood after the given week
+// Games played after that week count as remaining
+func StandingsAsOf(sched schedule.Schedule, week int) (Standings, error) {
+	if week < 0 || week > len(sched.Weeks) {
+		return Standings{}, fmt.Errorf("week %d is outside of the schedule", week)
+	}
+	return Compute(sched.Through(week))
+}
+
+// Snapshot is a team's place in the standings after a single week
+type Snapshot struct {
+	Week         int          `json:"week"`
+	Record       stats.Record `json:"record"`
+	DivisionRank int          `json:"divisionRank"`
+	Seed         int          `json:"seed"`
+	Clincher     string       `json:"clincher"`
+}
+
+// Timeline holds every team's snapshots, one per week in week order, keyed by team name
+type Timeline map[string][]Snapshot
+
+// NewTimeline computes the standings after every week of the schedule up to the last one with a result
+// Weeks after that would only repeat the latest standings, so they are left out
+func NewTimeline(sched schedule.Schedule) (Timeline, error) {
+	tl := make(Timeline)
+	for week := 1; week <= sched.LastPlayedWeek(); week++ {
+		st, err := StandingsAsOf(sched, week)
+		if err != nil {
+			return nil, fmt.Errorf("week %d: %w", week, err)
+		}
+
+		for _, e := range st.Entries {
+			tl[e.Team.Name] = append(tl[e.Team.Name], Snapshot{
+				Week:         week,
+				Record:       e.Stats.Record,
+				DivisionRank: e.Stats.DivisionRank,
+				Seed:         e.Stats.Seed,
+				Clincher:     e.Stats.Clincher,
+			})
+		}
+	}
+	return tl, nil
+}
+
+// Weeks returns the number of weeks covered by the timeline
+func (tl Timeline) Weeks() int {
+	for _, snapshots := range tl {
+		return len(snapshots)
+	}
+	return 0
+}
+
+// Week returns every team's snapshot after the given week, keyed by team name
+func (tl Timeline) Week(week int) map[string]Snapshot {
+	out := make(map[string]Snapshot)
+	for teamname, snapshots := range tl {
+		if week >= 1 && week <= len(snapshots) {
+			out[teamname] = snapshots[week-1]
+		}
+	}
+	return out
+}
@@ -0,0 +1,119 @@
+package standings
+
+import (
+	"nfl-app/internal/schedule"
+	"nfl-app/internal/team"
+	"testing"
+)
+
+// history returns a season where a beats b in week 1, b beats a in week 2 and a wins in week 3, with a game left each
+// in week 4
+func history() (sched schedule.Schedule, a, b string) {
+	east := teamsIn(team.AFCEast)
+	a, b = east[0], east[1]
+
+	s := newSeason()
+	s.beat(1, a, b)
+	s.beat(2, b, a)
+	s.win(3, a)
+	s.remaining(4, a)
+	s.remaining(4, b)
+	return s.sched, a, b
+}
+
+func TestStandingsAsOf(t *testing.T) {
+	sched, a, b := history()
+
+	for _, week := range []int{-1, len(sched.Weeks) + 1} {
+		if _, err := StandingsAsOf(sched, week); err == nil {
+			t.Errorf("expected an error for week %d", week)
+		}
+	}
+
+	tests := []struct {
+		week int
+		// records of a and b as wins and losses, later results left unplayed
+		a, b [2]int
+		// left is the number of games still to play
+		left int
+	}{
+		{0, [2]int{0, 0}, [2]int{0, 0}, 5},
+		{1, [2]int{1, 0}, [2]int{0, 1}, 4},
+		{2, [2]int{1, 1}, [2]int{1, 1}, 3},
+		{3, [2]int{2, 1}, [2]int{1, 1}, 2},
+		{len(sched.Weeks), [2]int{2, 1}, [2]int{1, 1}, 2},
+	}
+	for _, tt := range tests {
+		st, err := StandingsAsOf(sched, tt.week)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for name, want := range map[string][2]int{a: tt.a, b: tt.b} {
+			e, _ := st.Entry(name)
+			if got := [2]int{e.Stats.Record.Wins(), e.Stats.Record.Losses()}; got != want {
+				t.Errorf("week %d: expected %s to be %d-%d, got %d-%d", tt.week, name, want[0], want[1], got[0], got[1])
+			}
+		}
+
+		// Clinch indicators are judged against the games left as of the week
+		through := sched.Through(tt.week)
+		if left := len(through.RemainingGames()); left != tt.left {
+			t.Errorf("week %d: expected %d games left, got %d", tt.week, tt.left, left)
+		}
+		indicators := clinchersOf(t, through)
+		for _, e := range st.Entries {
+			if e.Stats.Clincher != indicators[e.Team.Name] {
+				t.Errorf("week %d: expected %s to have %q, got %q", tt.week, e.Team.Name, indicators[e.Team.Name], e.Stats.Clincher)
+			}
+		}
+	}
+}
+
+func TestNewTimeline(t *testing.T) {
+	sched, a, _ := history()
+
+	tl, err := NewTimeline(sched)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tl) != len(team.NFLTeams) || tl.Weeks() != 3 {
+		t.Fatalf("expected 3 weeks for all %d teams, got %d weeks for %d", len(team.NFLTeams), tl.Weeks(), len(tl))
+	}
+
+	for week := 1; week <= tl.Weeks(); week++ {
+		st, err := StandingsAsOf(sched, week)
+		if err != nil {
+			t.Fatal(err)
+		}
+		snapshots := tl.Week(week)
+		for _, e := range st.Entries {
+			want := Snapshot{
+				Week:         week,
+				Record:       e.Stats.Record,
+				DivisionRank: e.Stats.DivisionRank,
+				Seed:         e.Stats.Seed,
+				Clincher:     e.Stats.Clincher,
+			}
+			if got := snapshots[e.Team.Name]; got != want {
+				t.Errorf("week %d: expected %s to have %+v, got %+v", week, e.Team.Name, want, got)
+			}
+		}
+	}
+	if wins := tl[a][1].Record.Wins(); wins != 1 {
+		t.Errorf("expected %s to have 1 win after week 2, got %d", a, wins)
+	}
+
+	for _, week := range []int{0, 4} {
+		if got := tl.Week(week); len(got) != 0 {
+			t.Errorf("expected no snapshots for week %d, got %d", week, len(got))
+		}
+	}
+
+	empty, err := NewTimeline(schedule.NewSchedule())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if empty.Weeks() != 0 {
+		t.Errorf("expected no weeks before any games are played, got %d", empty.Weeks())
+	}
+}
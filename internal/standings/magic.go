@@ -0,0 +1,178 @@
+package standings
+
+import (
+	"nfl-app/internal/entry"
+	"nfl-app/internal/schedule"
+	"nfl-app/internal/stats"
+	"nfl-app/internal/team"
+)
+
+// Goals a magic or elimination number is measured against
+const (
+	GoalDivision     = "division"
+	GoalPlayoffBerth = "playoff berth"
+)
+
+// Number is a magic number, or an elimination number, between a team and the rival it is measured against
+//
+// A magic number is the combination of wins by the team and losses by the rival that guarantees the team finishes
+// ahead of the rival. An elimination number is the combination of wins by the rival and losses by the team that
+// guarantees the rival finishes ahead. Either way the rival is assumed to win any tiebreaker, until there are no games
+// left and the tiebreakers have settled the order
+//
+// A tie by either team counts as half a win and half a loss, so two ties take one off the value. A single tie takes
+// one off when the gap between the teams is a whole number of games, and leaves the value as it is when there is half
+// a game in it
+type Number struct {
+	Team  string
+	Rival string
+	Goal  string
+
+	// Elimination is true for an elimination number, false for a magic number
+	Elimination bool
+
+	// Value is zero once the goal is clinched, or the team eliminated, as the clinch indicators show
+	Value int
+
+	// HeadToHead is the number of games the team and rival still play against each other
+	HeadToHead int
+
+	// Games is the fewest results that can bring the value to zero. A head-to-head game counts
+	// as both a win and a loss, so it can be worth two
+	Games int
+}
+
+// MagicNumbers returns, for every team, a division number and a playoff berth number
+//
+// The division leader gets a magic number against the rival closest to catching it, and every other team gets an
+// elimination number against the leader. Likewise each seeded team gets a magic number against the unseeded team
+// closest to catching it, and every unseeded team gets an elimination number against the last seed
+//
+// The standings are those Compute returns for the schedule. A team that has clinched the goal, or been eliminated from
+// it, gets a number of zero
+func MagicNumbers(sched schedule.Schedule, st Standings) ([]Number, error) {
+	finished := len(sched.RemainingGames()) == 0
+	left := make(map[string]int)
+	headToHead := make(map[[2]string]int)
+	for _, sg := range sched.RemainingGames() {
+		left[sg.Game.Home]++
+		left[sg.Game.Away]++
+		headToHead[pairKey(sg.Game.Home, sg.Game.Away)]++
+	}
+
+	number := func(t, rival entry.Entry, goal string, elimination, settled bool) Number {
+		n := Number{
+			Team:        t.Team.Name,
+			Rival:       rival.Team.Name,
+			Goal:        goal,
+			Elimination: elimination,
+			HeadToHead:  headToHead[pairKey(t.Team.Name, rival.Team.Name)],
+		}
+
+		// An elimination number is the rival's magic number over the team
+		leader, trailer := t, rival
+		if elimination {
+			leader, trailer = rival, t
+		}
+		if !settled {
+			// With no games left the order is final, so the leader has won any tiebreaker
+			n.Value = magicValue(leader, trailer, left[trailer.Team.Name], finished)
+		}
+		n.Games = fewestGames(n.Value, n.HeadToHead)
+		return n
+	}
+
+	out := make([]Number, 0, 2*len(st.Entries))
+	for _, t := range team.NFLTeams {
+		e, _ := st.Entry(t.Name)
+
+		// Division
+		division := st.Division(t.Division)
+		if e.Stats.DivisionRank == 1 {
+			out = append(out, binding(e, division, func(rival entry.Entry) Number {
+				return number(e, rival, GoalDivision, false, clinchedDivision(e))
+			}))
+		} else {
+			// Once the leader has clinched the division, every other team is out of it
+			out = append(out, number(e, division[0], GoalDivision, true,
+				clinchedDivision(division[0]) || e.Stats.Clincher == stats.Eliminated))
+		}
+
+		// Playoff berth
+		conference := st.Conference(t.Conference)
+		if e.Stats.Seed <= PlayoffTeams {
+			out = append(out, binding(e, conference[PlayoffTeams:], func(rival entry.Entry) Number {
+				return number(e, rival, GoalPlayoffBerth, false, e.Stats.Clincher != "" && e.Stats.Clincher != stats.Eliminated)
+			}))
+		} else {
+			out = append(out, number(e, conference[PlayoffTeams-1], GoalPlayoffBerth, true, e.Stats.Clincher == stats.Eliminated))
+		}
+	}
+
+	return out, nil
+}
+
+// clinchedDivision reports whether the team's clinch indicator includes its division
+func clinchedDivision(e entry.Entry) bool {
+	switch e.Stats.Clincher {
+	case stats.ClinchedDivision, stats.ClinchedFirstRoundBye, stats.ClinchedHomeField:
+		return true
+	}
+	return false
+}
+
+// binding returns the largest magic number of the team against each of the rivals,
+// which is the one that must be reached to clinch
+func binding(e entry.Entry, rivals []entry.Entry, number func(rival entry.Entry) Number) Number {
+	var out Number
+	found := false
+	for _, rival := range rivals {
+		if rival.Team.Name == e.Team.Name {
+			continue
+		}
+		n := number(rival)
+		if !found || n.Value > out.Value {
+			out = n
+			found = true
+		}
+	}
+	return out
+}
+
+// magicValue returns the wins by the leader plus losses by the trailer that guarantee the leader finishes ahead, given
+// the trailer has the given number of games left. The leader must finish strictly ahead, or level when it is known to
+// win the tiebreaker. Every team plays the same number of games, so comparing wins counted as two points and ties as
+// one is the same as comparing win percentage. The value is still counted in whole wins and losses, and a tie moves
+// the gap by one point, half of what a win or loss does
+func magicValue(leader, trailer entry.Entry, trailerLeft int, winsTiebreaker bool) int {
+	lr, tr := leader.Stats.Record, trailer.Stats.Record
+	leaderPoints := 2*lr.Wins() + lr.Ties()
+	trailerBest := 2*(tr.Wins()+trailerLeft) + tr.Ties()
+
+	// Each leader win or trailer loss moves the gap by two points. Unless it wins the tiebreaker, the leader must end
+	// up at least one point ahead
+	gap := trailerBest - leaderPoints
+	if !winsTiebreaker {
+		gap++
+	}
+	if gap <= 0 {
+		return 0
+	}
+	return (gap + 1) / 2
+}
+
+// fewestGames returns the fewest results that make up the value, when each of the remaining
+// head-to-head games counts as both a win by one team and a loss by the other
+func fewestGames(value, headToHead int) int {
+	if value <= 2*headToHead {
+		return (value + 1) / 2
+	}
+	return value - headToHead
+}
+
+func pairKey(a, b string) [2]string {
+	if a > b {
+		a, b = b, a
+	}
+	return [2]string{a, b}
+}
@@ -0,0 +1,190 @@
+package standings
+
+import (
+	"nfl-app/internal/entry"
+	"nfl-app/internal/game"
+	"nfl-app/internal/stats"
+	"nfl-app/internal/team"
+	"testing"
+)
+
+func withRecord(name string, wins, losses, ties int) entry.Entry {
+	e := entry.NewEntry(name)
+	e.Stats.Record = *stats.NewRecord(wins, losses, ties)
+	return *e
+}
+
+func TestMagicValue(t *testing.T) {
+	tests := []struct {
+		name           string
+		leader         [3]int
+		trailer        [3]int
+		trailerLeft    int
+		winsTiebreaker bool
+		want           int
+	}{
+		{"two games ahead with three left", [3]int{10, 4, 0}, [3]int{8, 6, 0}, 3, false, 2},
+		{"can only finish level", [3]int{10, 4, 0}, [3]int{8, 6, 0}, 2, false, 1},
+		{"level, winning the tiebreaker", [3]int{10, 4, 0}, [3]int{8, 6, 0}, 2, true, 0},
+		{"clinched", [3]int{12, 2, 0}, [3]int{8, 6, 0}, 2, false, 0},
+		// With no games left, the tiebreakers have put the leader ahead
+		{"no games left", [3]int{8, 6, 0}, [3]int{8, 6, 0}, 0, true, 0},
+		// A tie is worth one point to a win's two. From two games ahead, a leader tie takes one off
+		{"leader tie on a whole-game gap", [3]int{10, 3, 1}, [3]int{8, 6, 0}, 3, false, 1},
+		// From half a game ahead it leaves the number as it is
+		{"half a game ahead", [3]int{10, 4, 0}, [3]int{8, 5, 1}, 2, false, 1},
+		{"leader tie on a half-game gap", [3]int{10, 3, 1}, [3]int{8, 5, 1}, 2, false, 1},
+		{"half a game ahead, winning the tiebreaker", [3]int{10, 4, 0}, [3]int{8, 5, 1}, 2, true, 1},
+		// The trailer's ties count towards its best finish
+		{"trailer tie", [3]int{10, 4, 0}, [3]int{8, 5, 1}, 3, false, 2},
+		{"level on ties", [3]int{9, 4, 1}, [3]int{9, 4, 1}, 1, false, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			leader := withRecord("leader", tt.leader[0], tt.leader[1], tt.leader[2])
+			trailer := withRecord("trailer", tt.trailer[0], tt.trailer[1], tt.trailer[2])
+			if got := magicValue(leader, trailer, tt.trailerLeft, tt.winsTiebreaker); got != tt.want {
+				t.Errorf("expected %d, got %d", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestFewestGames(t *testing.T) {
+	tests := []struct {
+		value, headToHead, want int
+	}{
+		{0, 1, 0},
+		{3, 0, 3},
+		// A head-to-head win is both a win and a rival loss
+		{1, 1, 1},
+		{2, 1, 1},
+		{3, 1, 2},
+		{4, 2, 2},
+		{3, 2, 2},
+		{5, 2, 3},
+	}
+	for _, tt := range tests {
+		if got := fewestGames(tt.value, tt.headToHead); got != tt.want {
+			t.Errorf("fewestGames(%d, %d): expected %d, got %d", tt.value, tt.headToHead, tt.want, got)
+		}
+	}
+}
+
+func TestBinding(t *testing.T) {
+	east := teamsIn(team.AFCEast)
+	self, a, b, c := east[0], east[1], east[2], east[3]
+	// The team's own number is the largest, but it is never measured against itself
+	values := map[string]int{self: 9, a: 1, b: 3, c: 2}
+	var rivals []entry.Entry
+	for _, name := range []string{a, self, b, c} {
+		rivals = append(rivals, *entry.NewEntry(name))
+	}
+
+	n := binding(*entry.NewEntry(self), rivals, func(rival entry.Entry) Number {
+		return Number{Team: self, Rival: rival.Team.Name, Value: values[rival.Team.Name]}
+	})
+	if n.Rival != b || n.Value != 3 {
+		t.Errorf("expected the number of 3 against %s, got %d against %q", b, n.Value, n.Rival)
+	}
+}
+
+func TestMagicNumbers(t *testing.T) {
+	east := teamsIn(team.AFCEast)
+	a, b := east[0], east[1]
+
+	tests := []struct {
+		name string
+		// ties is whether a and b tie their third game, rather than a winning it and b losing it
+		ties bool
+		// value and games are the same for a's magic number and b's elimination number
+		value, games int
+	}{
+		// a is 3-0 and b 1-2, and both have a game against each other and one against an NFC team left. b can at best
+		// finish 3-2, level with a and winning the tiebreaker on their only meeting, so it takes one win by a or loss
+		// by b, and the head-to-head game is both
+		{"wins", false, 1, 1},
+		// At 2-0-1 and 1-1-1, b can finish a game ahead of a, so it takes two, and the head-to-head game alone is
+		// still enough
+		{"ties", true, 2, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newSeason()
+			s.win(1, a)
+			s.lose(1, b)
+			s.win(2, a)
+			s.win(2, b)
+			if tt.ties {
+				s.tie(3, a)
+				s.tie(3, b)
+			} else {
+				s.win(3, a)
+				s.lose(3, b)
+			}
+			s.sched.AddRemaining(4, game.Game{Home: a, Away: b})
+			s.remaining(5, a)
+			s.remaining(5, b)
+
+			st, err := Compute(s.sched)
+			if err != nil {
+				t.Fatal(err)
+			}
+			numbers, err := MagicNumbers(s.sched, st)
+			if err != nil {
+				t.Fatal(err)
+			}
+			goals := make(map[string]int)
+			byTeam := make(map[string]Number)
+			for _, n := range numbers {
+				goals[n.Goal]++
+				if n.Goal == GoalDivision {
+					byTeam[n.Team] = n
+				}
+			}
+			if goals[GoalDivision] != len(team.NFLTeams) || goals[GoalPlayoffBerth] != len(team.NFLTeams) {
+				t.Errorf("expected a division and a berth number for every team, got %v", goals)
+			}
+
+			want := map[string]Number{
+				a: {Team: a, Rival: b, Goal: GoalDivision, Value: tt.value, HeadToHead: 1, Games: tt.games},
+				b: {Team: b, Rival: a, Goal: GoalDivision, Elimination: true, Value: tt.value, HeadToHead: 1, Games: tt.games},
+			}
+			for name, w := range want {
+				if got := byTeam[name]; got != w {
+					t.Errorf("expected %+v, got %+v", w, got)
+				}
+			}
+		})
+	}
+}
+
+// TestMagicNumbersAgreeWithClinchers checks the 2023 numbers against the clinch indicators shown next to them, after
+// week 14 and at the end of the season, when every number is zero
+func TestMagicNumbersAgreeWithClinchers(t *testing.T) {
+	for _, week := range []int{14, 18} {
+		sched := season2023(t, week)
+		st, err := Compute(sched)
+		if err != nil {
+			t.Fatal(err)
+		}
+		numbers, err := MagicNumbers(sched, st)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, n := range numbers {
+			e, _ := st.Entry(n.Team)
+			clincher := e.Stats.Clincher
+			settled := week == 18 ||
+				(n.Goal == GoalPlayoffBerth && clincher != "") ||
+				(n.Goal == GoalDivision && !n.Elimination && clinchedDivision(e))
+			if settled && n.Value != 0 {
+				t.Errorf("week %d: expected %s (%q) to have a %s number of 0, got %+v", week, n.Team, clincher, n.Goal, n)
+			}
+			if n.Value == 0 && !n.Elimination && n.Goal == GoalPlayoffBerth && clincher == "" {
+				t.Errorf("week %d: expected %s to have clinched a berth with a magic number of 0", week, n.Team)
+			}
+		}
+	}
+}
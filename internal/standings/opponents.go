@@ -0,0 +1,60 @@
+package standings
+
+import (
+	"fmt"
+	"nfl-app/internal/entry"
+	"nfl-app/internal/stats"
+	"nfl-app/internal/team"
+	"slices"
+)
+
+// Opponents is a team's opponents in a season, split by where the games are played
+type Opponents struct {
+	Home []team.Team `json:"home"`
+	Away []team.Team `json:"away"`
+}
+
+// NextSeasonOpponents returns every team's opponents in the season after the given one, keyed by team name
+// The scheduling formula pairs teams by where they finished in their division, so the standings should be final
+func NextSeasonOpponents(st Standings, season int) (map[string]Opponents, error) {
+	places := make(map[string]int)
+	for _, e := range st.Entries {
+		places[e.Team.Name] = e.Stats.DivisionRank
+	}
+
+	matchups, err := team.SeasonMatchups(season+1, places)
+	if err != nil {
+		return nil, fmt.Errorf("placing teams by division rank: %w", err)
+	}
+
+	out := make(map[string]Opponents)
+	for _, m := range matchups {
+		home, away := out[m.Home.Name], out[m.Away.Name]
+		home.Home = append(home.Home, m.Away)
+		away.Away = append(away.Away, m.Home)
+		out[m.Home.Name], out[m.Away.Name] = home, away
+	}
+	return out, nil
+}
+
+// ProjectStrengthOfSchedule returns the standings with each team's projected strength of schedule set: the combined
+// record in the given season of its opponents in the next. The given standings are left alone
+func ProjectStrengthOfSchedule(st Standings, season int) (Standings, error) {
+	opponents, err := NextSeasonOpponents(st, season)
+	if err != nil {
+		return Standings{}, err
+	}
+
+	byTeam := entry.ByTeam(st.Entries)
+	st.Entries = slices.Clone(st.Entries)
+	for i, e := range st.Entries {
+		combined := stats.NewRecord(0, 0, 0)
+		opp := opponents[e.Team.Name]
+		for _, t := range slices.Concat(opp.Home, opp.Away) {
+			record := byTeam[t.Name].Stats.Record
+			combined.Add(&record)
+		}
+		st.Entries[i].Stats.ProjectedStrengthOfSchedule = combined.WinPercentage()
+	}
+	return st, nil
+}
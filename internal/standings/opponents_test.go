@@ -0,0 +1,127 @@
+package standings
+
+import (
+	"math"
+	"math/rand"
+	"nfl-app/internal/team"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestNextSeasonOpponents(t *testing.T) {
+	sched, results := randomSeason(rand.New(rand.NewSource(2)), 17)
+	for _, sg := range results {
+		if err := sched.Play(sg.Week, sg.Game); err != nil {
+			t.Fatal(err)
+		}
+	}
+	st, err := Rank(sched)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opponents, err := NextSeasonOpponents(st, 2024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range st.Entries {
+		opp := opponents[e.Team.Name]
+		if len(opp.Home)+len(opp.Away) != 17 || len(opp.Home) < 8 || len(opp.Home) > 9 {
+			t.Errorf("%s: expected 17 games with 8 or 9 at home, got %d home and %d away", e.Team.Name, len(opp.Home), len(opp.Away))
+		}
+	}
+
+	// The division winners meet in the same place games. In 2025 the AFC East plays the AFC North in the rotation
+	// and the AFC West only in the same place game
+	east, west := st.Division("AFC East")[0].Team, st.Division("AFC West")[0].Team
+	opp := opponents[east.Name]
+	if !slices.Contains(opp.Home, west) && !slices.Contains(opp.Away, west) {
+		t.Errorf("expected %s to play %s", east.Name, west.Name)
+	}
+	for _, tm := range append(opp.Home, opp.Away...) {
+		if tm.Division == "AFC West" && tm != west {
+			t.Errorf("expected %s to play only the AFC West winner, got %s", east.Name, tm.Name)
+		}
+	}
+}
+
+// TestNextSeasonOpponents2024 checks the opponents drawn from the final 2023 standings against the 2024 schedule
+func TestNextSeasonOpponents2024(t *testing.T) {
+	st, err := Rank(season2023(t, 18))
+	if err != nil {
+		t.Fatal(err)
+	}
+	opponents, err := NextSeasonOpponents(st, 2023)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sched := snapshot(t, 2024)
+	want := make(map[string]Opponents)
+	for _, w := range sched.Weeks {
+		for _, g := range w.Games {
+			home, _ := team.Lookup(g.Home)
+			away, _ := team.Lookup(g.Away)
+			h, a := want[g.Home], want[g.Away]
+			h.Home = append(h.Home, away)
+			a.Away = append(a.Away, home)
+			want[g.Home], want[g.Away] = h, a
+		}
+	}
+
+	// The Bills hosted Arizona, Jacksonville, Tennessee, Miami, Kansas City, San Francisco, the Jets and New England
+	bills := opponents[team.BuffaloBills.Name]
+	for _, name := range []string{"ARI", "JAX", "TEN", "MIA", "KC", "SF", "NYJ", "NE"} {
+		tm, _ := team.Lookup(name)
+		if !slices.Contains(bills.Home, tm) {
+			t.Errorf("expected the Bills to host %s, got %s", name, team.Names(bills.Home))
+		}
+	}
+
+	byName := func(a, b team.Team) int { return strings.Compare(a.Name, b.Name) }
+	for _, tm := range team.NFLTeams {
+		got, exp := opponents[tm.Name], want[tm.Name]
+		for _, list := range [][]team.Team{got.Home, got.Away, exp.Home, exp.Away} {
+			slices.SortFunc(list, byName)
+		}
+		if !slices.Equal(got.Home, exp.Home) || !slices.Equal(got.Away, exp.Away) {
+			t.Errorf("%s:\n got home %s, away %s\nwant home %s, away %s", tm.Abbreviation,
+				team.Names(got.Home), team.Names(got.Away), team.Names(exp.Home), team.Names(exp.Away))
+		}
+	}
+}
+
+func TestProjectStrengthOfSchedule(t *testing.T) {
+	sched, results := randomSeason(rand.New(rand.NewSource(3)), 17)
+	for _, sg := range results {
+		if err := sched.Play(sg.Week, sg.Game); err != nil {
+			t.Fatal(err)
+		}
+	}
+	st, err := Rank(sched)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	projected, err := ProjectStrengthOfSchedule(st, 2024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opponents, _ := NextSeasonOpponents(st, 2024)
+	for _, e := range projected.Entries {
+		wins := 0.0
+		opp := opponents[e.Team.Name]
+		for _, tm := range append(opp.Home, opp.Away...) {
+			o, _ := st.Entry(tm.Name)
+			wins += o.Stats.Record.WinPercentage()
+		}
+		// Every team played 17 games, so the combined record is the average of the opponents' percentages
+		if want := wins / 17; math.Abs(e.Stats.ProjectedStrengthOfSchedule-want) > 1e-9 {
+			t.Errorf("%s: got %.3f, want %.3f", e.Team.Name, e.Stats.ProjectedStrengthOfSchedule, want)
+		}
+	}
+	if st.Entries[0].Stats.ProjectedStrengthOfSchedule != 0 {
+		t.Errorf("expected the given standings to be left alone")
+	}
+}
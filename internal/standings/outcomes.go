@@ -0,0 +1,43 @@
+package standings
+
+import (
+	"nfl-app/internal/game"
+)
+
+// Outcome is a possible result of a game that has not been played yet
+type Outcome int
+
+const (
+	HomeWin Outcome = iota
+	AwayWin
+	Tie
+)
+
+// Outcomes holds every possible result of a single game
+var Outcomes = []Outcome{HomeWin, AwayWin, Tie}
+
+func (o Outcome) String() string {
+	switch o {
+	case HomeWin:
+		return "home win"
+	case AwayWin:
+		return "away win"
+	case Tie:
+		return "tie"
+	}
+	return "unknown"
+}
+
+// Result fills in the result of the given game according to the outcome
+// Hypothetical results have no score, so both teams are credited with zero points
+func (o Outcome) Result(g game.Game) game.Game {
+	switch o {
+	case HomeWin:
+		g.Winner, g.Loser = g.Home, g.Away
+	case AwayWin:
+		g.Winner, g.Loser = g.Away, g.Home
+	default:
+		g.Winner, g.Loser = "", ""
+	}
+	return g
+}
@@ -0,0 +1,198 @@
+package standings
+
+import (
+	"fmt"
+	"nfl-app/internal/entry"
+	"nfl-app/internal/entrysort"
+	"nfl-app/internal/schedule"
+	"nfl-app/internal/team"
+	"sort"
+)
+
+// Standings holds an entry for every team, with division ranks, seeds and clinch indicators populated
+type Standings struct {
+	Entries       []entry.Entry
+	TeamSchedules map[string]schedule.Schedule
+}
+
+// Compute builds the standings for the given schedule, including clinch indicators
+// computed against the schedule's remaining games
+func Compute(sched schedule.Schedule) (Standings, error) {
+	st, err := Rank(sched)
+	if err != nil {
+		return Standings{}, err
+	}
+
+	if err := annotateClinchers(sched, st); err != nil {
+		return Standings{}, err
+	}
+
+	return st, nil
+}
+
+// ComputeTrace is Compute, also returning the trace of every division ranking and conference seeding, keyed by
+// division or conference. The traces come from the same pass that set the division ranks and seeds
+func ComputeTrace(sched schedule.Schedule) (Standings, map[string]*entrysort.Trace, error) {
+	tr := make(traces)
+	st, err := rank(sched, tr)
+	if err != nil {
+		return Standings{}, nil, err
+	}
+
+	if err := annotateClinchers(sched, st); err != nil {
+		return Standings{}, nil, err
+	}
+
+	return st, tr, nil
+}
+
+// Rank builds the standings, ranking every division and seeding every conference, without computing clinch indicators
+// This is much cheaper than Compute and is what should be used when evaluating many hypothetical schedules
+func Rank(sched schedule.Schedule) (Standings, error) {
+	return rank(sched, nil)
+}
+
+// traces holds the trace of every division ranking and conference seeding, keyed by division or conference
+type traces map[string]*entrysort.Trace
+
+// rank is Rank, also recording the traces when tr is not nil
+func rank(sched schedule.Schedule, tr traces) (Standings, error) {
+	st := unranked(sched)
+
+	for _, div := range team.Divisions {
+		if err := st.rankDivision(div, tr); err != nil {
+			return Standings{}, err
+		}
+	}
+	for _, conf := range team.Conferences {
+		if err := st.seedConference(conf, tr); err != nil {
+			return Standings{}, err
+		}
+	}
+
+	return st, nil
+}
+
+// unranked builds the entries for the schedule, without division ranks or seeds
+func unranked(sched schedule.Schedule) Standings {
+	entries := schedule.CreateEntries(sched)
+
+	// CreateEntries only covers teams that have played. Make sure every team has an entry
+	seen := make(map[string]bool)
+	for _, e := range entries {
+		seen[e.Team.Name] = true
+	}
+	for _, t := range team.NFLTeams {
+		if !seen[t.Name] {
+			entries = append(entries, *entry.NewEntry(t.Name))
+		}
+	}
+
+	return Standings{
+		Entries:       entries,
+		TeamSchedules: sched.SplitToTeams(),
+	}
+}
+
+// rankDivision sets the division rank of every team in the division
+func (s *Standings) rankDivision(division string, tr traces) error {
+	divEntries := make([]entry.Entry, 0)
+	for _, e := range s.Entries {
+		if e.Team.Division == division {
+			divEntries = append(divEntries, e)
+		}
+	}
+
+	var sorted []entry.Entry
+	var err error
+	if tr != nil {
+		sorted, tr[division], err = entrysort.SortEntriesTrace(divEntries, s.TeamSchedules)
+	} else {
+		sorted, err = entrysort.SortEntries(divEntries, s.TeamSchedules)
+	}
+	if err != nil {
+		return fmt.Errorf("sorting %s: %w", division, err)
+	}
+
+	ranks := make(map[string]int)
+	for i, e := range sorted {
+		ranks[e.Team.Name] = i + 1
+	}
+	for i := range s.Entries {
+		if rank, ok := ranks[s.Entries[i].Team.Name]; ok {
+			s.Entries[i].Stats.DivisionRank = rank
+		}
+	}
+	return nil
+}
+
+// seedConference sets the seed of every team in the conference
+func (s *Standings) seedConference(conference string, tr traces) error {
+	confEntries := entry.ConferenceEntries(s.Entries, conference)
+	var seeded []entry.Entry
+	var err error
+	if tr != nil {
+		seeded, tr[conference], err = entrysort.SeedEntriesTrace(confEntries, s.TeamSchedules)
+	} else {
+		seeded, err = entrysort.SeedEntries(confEntries, s.TeamSchedules)
+	}
+	if err != nil {
+		return fmt.Errorf("seeding %s: %w", conference, err)
+	}
+
+	seeds := make(map[string]int)
+	for _, e := range seeded {
+		seeds[e.Team.Name] = e.Stats.Seed
+	}
+	for i := range s.Entries {
+		if seed, ok := seeds[s.Entries[i].Team.Name]; ok {
+			s.Entries[i].Stats.Seed = seed
+		}
+	}
+	return nil
+}
+
+// Entry returns the entry for the given team
+func (s *Standings) Entry(teamname string) (entry.Entry, bool) {
+	for _, e := range s.Entries {
+		if e.Team.Name == teamname {
+			return e, true
+		}
+	}
+	return entry.Entry{}, false
+}
+
+// Division returns the entries in the given division, ordered by division rank
+func (s *Standings) Division(division string) []entry.Entry {
+	out := make([]entry.Entry, 0)
+	for _, e := range s.Entries {
+		if e.Team.Division == division {
+			out = append(out, e)
+		}
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Stats.DivisionRank < out[j].Stats.DivisionRank
+	})
+	return out
+}
+
+// Conference returns the entries in the given conference, ordered by seed
+func (s *Standings) Conference(conference string) []entry.Entry {
+	out := entry.ConferenceEntries(s.Entries, conference)
+
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Stats.Seed < out[j].Stats.Seed
+	})
+	return out
+}
+
+// Seeded returns the entries of each conference ordered by seed, keyed by conference
+// This is the shape expected by playoff.NewBracket
+func (s *Standings) Seeded() map[string][]entry.Entry {
+	out := make(map[string][]entry.Entry)
+	for _, conf := range team.Conferences {
+		out[conf] = s.Conference(conf)
+	}
+	return out
+}
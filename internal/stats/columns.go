@@ -0,0 +1,72 @@
+package stats
+
+import "slices"
+
+// Column is a stat teams can be ordered by
+type Column struct {
+	// Name identifies the column in flags and query parameters, matching its JSON field where it has one
+	Name   string
+	Header string
+
+	Value func(s *Stats) float64
+
+	// LowerIsBetter is set for stats a team wants less of, such as points allowed
+	LowerIsBetter bool
+}
+
+// Better reports whether a ranks ahead of b by the column
+func (c Column) Better(a, b *Stats) bool {
+	if c.LowerIsBetter {
+		return c.Value(a) < c.Value(b)
+	}
+	return c.Value(a) > c.Value(b)
+}
+
+// The average margins per game, which the simple ratings can be worked out from
+var (
+	PointMargin = Column{Name: "pointDifferentialPerGame", Header: "Diff PG",
+		Value: func(s *Stats) float64 { return s.PerGame(s.Points.Differential()) }}
+	YardMargin = Column{Name: "yardDifferentialPerGame", Header: "Yds Diff PG",
+		Value: func(s *Stats) float64 { return s.PerGame(s.Yards.Differential()) }}
+	TurnoverMargin = Column{Name: "turnoverDifferentialPerGame", Header: "TO +/- PG",
+		Value: func(s *Stats) float64 { return s.PerGame(s.Turnovers.Differential()) }}
+)
+
+// Columns are the stats teams can be sorted by
+var Columns = []Column{
+	{Name: "winPercentage", Header: "Pct", Value: func(s *Stats) float64 { return s.Record.WinPercentage() }},
+	{Name: "pointsFor", Header: "PF", Value: func(s *Stats) float64 { return float64(s.Points.For) }},
+	{Name: "pointsAgainst", Header: "PA", Value: func(s *Stats) float64 { return float64(s.Points.Against) }, LowerIsBetter: true},
+	{Name: "pointDifferential", Header: "Diff", Value: func(s *Stats) float64 { return float64(s.Points.Differential()) }},
+	PointMargin,
+	{Name: "yardsGained", Header: "Yds", Value: func(s *Stats) float64 { return float64(s.Yards.Gained) }},
+	{Name: "yardsAllowed", Header: "Yds Allowed", Value: func(s *Stats) float64 { return float64(s.Yards.Allowed) }, LowerIsBetter: true},
+	{Name: "yardDifferential", Header: "Yds Diff", Value: func(s *Stats) float64 { return float64(s.Yards.Differential()) }},
+	YardMargin,
+	{Name: "yardsGainedPerGame", Header: "YPG", Value: func(s *Stats) float64 { return s.PerGame(s.Yards.Gained) }},
+	{Name: "yardsAllowedPerGame", Header: "YAPG", Value: func(s *Stats) float64 { return s.PerGame(s.Yards.Allowed) }, LowerIsBetter: true},
+	{Name: "turnoversCommitted", Header: "Giveaways", Value: func(s *Stats) float64 { return float64(s.Turnovers.Committed) }, LowerIsBetter: true},
+	{Name: "turnoversForced", Header: "Takeaways", Value: func(s *Stats) float64 { return float64(s.Turnovers.Forced) }},
+	{Name: "turnoverDifferential", Header: "TO +/-", Value: func(s *Stats) float64 { return float64(s.Turnovers.Differential()) }},
+	TurnoverMargin,
+	{Name: "srs", Header: "SRS", Value: func(s *Stats) float64 { return s.SRS }},
+	{Name: "luck", Header: "Luck", Value: func(s *Stats) float64 { return s.Luck }},
+}
+
+// LookupColumn finds a column by name
+func LookupColumn(name string) (Column, bool) {
+	i := slices.IndexFunc(Columns, func(c Column) bool { return c.Name == name })
+	if i < 0 {
+		return Column{}, false
+	}
+	return Columns[i], true
+}
+
+// ColumnNames returns the name of every column, in order
+func ColumnNames() []string {
+	out := make([]string, len(Columns))
+	for i, c := range Columns {
+		out[i] = c.Name
+	}
+	return out
+}
@@ -0,0 +1,104 @@
+package stats
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// recordJSON is the JSON shape of a Record: {"wins": 10, "losses": 6, "ties": 1}
+type recordJSON struct {
+	Wins   int `json:"wins"`
+	Losses int `json:"losses"`
+	Ties   int `json:"ties"`
+}
+
+// MarshalJSON encodes the record's counts, which are otherwise unexported
+func (r Record) MarshalJSON() ([]byte, error) {
+	return json.Marshal(recordJSON{r.wins, r.losses, r.ties})
+}
+
+// UnmarshalJSON decodes a record encoded by MarshalJSON. Missing counts are zero, negative counts are rejected
+func (r *Record) UnmarshalJSON(data []byte) error {
+	var v recordJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	if v.Wins < 0 || v.Losses < 0 || v.Ties < 0 {
+		return fmt.Errorf("record cannot have negative counts: %d-%d-%d", v.Wins, v.Losses, v.Ties)
+	}
+
+	*r = Record{wins: v.Wins, losses: v.Losses, ties: v.Ties}
+	return nil
+}
+
+// pointsJSON is the JSON shape of Points: {"for": 420, "against": 311}
+type pointsJSON struct {
+	For     int `json:"for"`
+	Against int `json:"against"`
+}
+
+// MarshalJSON encodes the points scored and allowed
+func (p Points) MarshalJSON() ([]byte, error) {
+	return json.Marshal(pointsJSON(p))
+}
+
+// UnmarshalJSON decodes points encoded by MarshalJSON. Negative points are rejected
+func (p *Points) UnmarshalJSON(data []byte) error {
+	var v pointsJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	if v.For < 0 || v.Against < 0 {
+		return fmt.Errorf("points cannot be negative: %d for, %d against", v.For, v.Against)
+	}
+
+	*p = Points(v)
+	return nil
+}
+
+// statsJSON has the same fields as Stats without its methods, so the field tags on Stats describe its JSON shape
+type statsJSON Stats
+
+// statsOutJSON adds the averages worked out from the stats, which are left out when decoding
+type statsOutJSON struct {
+	statsJSON
+
+	TurnoverDifferential        int     `json:"turnoverDifferential"`
+	YardsGainedPerGame          float64 `json:"yardsGainedPerGame"`
+	YardsAllowedPerGame         float64 `json:"yardsAllowedPerGame"`
+	TurnoversCommittedPerGame   float64 `json:"turnoversCommittedPerGame"`
+	TurnoversForcedPerGame      float64 `json:"turnoversForcedPerGame"`
+	TurnoverDifferentialPerGame float64 `json:"turnoverDifferentialPerGame"`
+}
+
+// MarshalJSON encodes every field of the stats under the names in the field tags, along with the turnover
+// differential and the per game averages of yards and turnovers
+func (s Stats) MarshalJSON() ([]byte, error) {
+	return json.Marshal(statsOutJSON{
+		statsJSON: statsJSON(s),
+
+		TurnoverDifferential:        s.Turnovers.Differential(),
+		YardsGainedPerGame:          s.PerGame(s.Yards.Gained),
+		YardsAllowedPerGame:         s.PerGame(s.Yards.Allowed),
+		TurnoversCommittedPerGame:   s.PerGame(s.Turnovers.Committed),
+		TurnoversForcedPerGame:      s.PerGame(s.Turnovers.Forced),
+		TurnoverDifferentialPerGame: s.PerGame(s.Turnovers.Differential()),
+	})
+}
+
+// UnmarshalJSON decodes stats encoded by MarshalJSON. Missing fields are left at zero, and unknown clinch indicators are rejected
+func (s *Stats) UnmarshalJSON(data []byte) error {
+	var v statsJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	switch v.Clincher {
+	case "", ClinchedPlayoffBerth, ClinchedDivision, ClinchedFirstRoundBye, ClinchedHomeField, Eliminated:
+	default:
+		return fmt.Errorf("unknown clinch indicator %q", v.Clincher)
+	}
+
+	*s = Stats(v)
+	return nil
+}
@@ -1,8 +1,10 @@
 package stats
 
+import "math"
+
 type Points struct {
-	For     int
-	Against int
+	For     int `json:"for"`
+	Against int `json:"against"`
 }
 
 func (p *Points) Differential() int {
@@ -16,3 +18,17 @@ func (p *Points) AddFor(other int) {
 func (p *Points) AddAgainst(other int) {
 	p.Against += other
 }
+
+// PythagoreanExponent is the exponent commonly used for the NFL in the Pythagorean expectation
+const PythagoreanExponent = 2.37
+
+// PythagoreanWinPercentage is the share of games a team scoring and allowing these points would be expected to win
+// It is 0.5 before any points have been scored or allowed
+func (p *Points) PythagoreanWinPercentage() float64 {
+	if p.For == 0 && p.Against == 0 {
+		return 0.5
+	}
+	scored := math.Pow(float64(p.For), PythagoreanExponent)
+	allowed := math.Pow(float64(p.Against), PythagoreanExponent)
+	return scored / (scored + allowed)
+}
@@ -2,33 +2,68 @@ package stats
 
 type Stats struct {
 	// Records
-	Record           Record
-	HomeRecord       Record
-	AwayRecord       Record
-	DivisionRecord   Record
-	ConferenceRecord Record
+	Record           Record `json:"record"`
+	HomeRecord       Record `json:"homeRecord"`
+	AwayRecord       Record `json:"awayRecord"`
+	DivisionRecord   Record `json:"divisionRecord"`
+	ConferenceRecord Record `json:"conferenceRecord"`
 
 	// Points
-	Points           Points
-	ConferencePoints Points
+	Points           Points `json:"points"`
+	ConferencePoints Points `json:"conferencePoints"`
+
+	// Yards and turnovers, 0 for games the results did not record them for
+	Yards     Yards     `json:"yards"`
+	Turnovers Turnovers `json:"turnovers"`
 
 	// Strength Of
-	StrengthOfVictory  float64
-	StrengthOfSchedule float64
+	StrengthOfVictory  float64 `json:"strengthOfVictory"`
+	StrengthOfSchedule float64 `json:"strengthOfSchedule"`
+
+	// RemainingStrengthOfSchedule is the combined current win percentage of the opponents still to be played
+	RemainingStrengthOfSchedule float64 `json:"remainingStrengthOfSchedule"`
+
+	// ProjectedStrengthOfSchedule is the combined win percentage this season of next season's opponents
+	// Only set by standings.ProjectStrengthOfSchedule
+	ProjectedStrengthOfSchedule float64 `json:"projectedStrengthOfSchedule"`
+
+	// Ratings
+	// SRS (simple rating system) is the average margin of victory adjusted for the strength of the opponents
+	SRS float64 `json:"srs"`
+
+	// ExpectedWins are the wins expected from points scored and allowed, by the Pythagorean expectation
+	// Luck is the actual wins less those, ties counting as half a win
+	ExpectedWins float64 `json:"expectedWins"`
+	Luck         float64 `json:"luck"`
 
 	// Rank
-	ConferenceRankPointsFor     int
-	ConferenceRankPointsAgainst int
-	LeagueRankPointsFor         int
-	LeagueRankPointsAgainst     int
+	ConferenceRankPointsFor     int `json:"conferenceRankPointsFor"`
+	ConferenceRankPointsAgainst int `json:"conferenceRankPointsAgainst"`
+	LeagueRankPointsFor         int `json:"leagueRankPointsFor"`
+	LeagueRankPointsAgainst     int `json:"leagueRankPointsAgainst"`
 
-	Streak int
+	Streak int `json:"streak"`
 
-	Seed int
+	// Standing
+	DivisionRank int `json:"divisionRank"`
+	Seed         int `json:"seed"`
 
-	// TODO: Clincher
+	// Clincher is the official clinch indicator (see the Clinched* constants), empty if nothing has been clinched
+	// An indicator is only given once proven, so a missing one can also mean the proof was cut short, see
+	// standings.MaxClinchLeaves
+	Clincher string `json:"clincher"`
 }
 
+// Clinch indicators, as shown next to a team in the standings. Each is only given once it holds in every outcome of
+// the remaining games; a search cut short leaves a team with a weaker indicator, or none, rather than a wrong one
+const (
+	ClinchedPlayoffBerth  = "x"
+	ClinchedDivision      = "y"
+	ClinchedFirstRoundBye = "z"
+	ClinchedHomeField     = "*"
+	Eliminated            = "e"
+)
+
 func NewStats() Stats {
 	return Stats{}
 }
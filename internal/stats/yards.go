@@ -0,0 +1,31 @@
+package stats
+
+// Yards are the yards a team has gained and allowed
+type Yards struct {
+	Gained  int `json:"gained"`
+	Allowed int `json:"allowed"`
+}
+
+func (y *Yards) Differential() int {
+	return y.Gained - y.Allowed
+}
+
+// Turnovers are the turnovers a team has committed and forced
+type Turnovers struct {
+	Committed int `json:"committed"`
+	Forced    int `json:"forced"`
+}
+
+// Differential is the turnover margin, positive when a team has forced more turnovers than it committed
+func (t *Turnovers) Differential() int {
+	return t.Forced - t.Committed
+}
+
+// PerGame returns the average of a total over the games played, 0 before any have been
+func (s *Stats) PerGame(total int) float64 {
+	games := s.Record.GamesPlayed()
+	if games == 0 {
+		return 0
+	}
+	return float64(total) / float64(games)
+}
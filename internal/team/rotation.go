@@ -0,0 +1,270 @@
+package team
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// The league's scheduling formula gives every team 17 games:
+//   - 6 against its division, home and away
+//   - 4 against the teams of a division in its conference, on a three year rotation
+//   - 4 against the teams of a division in the other conference, on a four year rotation
+//   - 2 against the teams that finished in the same place in the two other divisions of its conference
+//   - 1 against the team that finished in the same place in the division of the other conference it played two years
+//     before, the seventeenth game, hosted by the AFC in odd years and the NFC in even years
+//
+// Divisions are numbered East, North, South, West within their conference. The tables below follow the rotations
+// the league has used since the seventeenth game was added in 2021
+
+// intraRotation gives the conference division each division plays, indexed by season % 3 and then by division
+var intraRotation = [3][4]int{
+	{1, 0, 3, 2}, // 2025: East-North, South-West
+	{3, 2, 1, 0}, // 2023: East-West, North-South
+	{2, 3, 0, 1}, // 2024: East-South, North-West
+}
+
+// interRotation gives the NFC division each AFC division plays, indexed by season % 4 and then by AFC division
+var interRotation = [4][4]int{
+	{3, 0, 1, 2}, // 2024
+	{2, 1, 3, 0}, // 2025
+	{1, 2, 0, 3}, // 2022
+	{0, 3, 2, 1}, // 2023
+}
+
+// rotationHosts lists each team followed by the rotation games it hosted over the last full turn of both rotations, the
+// conference games of 2021 to 2023 and the games across conferences of 2020 to 2023. Every time two teams meet again
+// in the rotation the site is reversed, so these decide the sites of every other season. The Falcons' 2023 game
+// against the Jaguars at Wembley stood in for a Jaguars home game and is listed as the formula had it
+var rotationHosts = map[int][]string{
+	2020: {
+		"NE SF ARI", "NYJ SF ARI", "BUF LAR SEA", "MIA LAR SEA", "PIT PHI WAS", "BAL DAL NYG",
+		"CLE PHI WAS", "CIN DAL NYG", "TEN CHI DET", "IND GB MIN", "JAX CHI DET", "HOU GB MIN",
+		"KC CAR ATL", "LV TB NO", "LAC CAR ATL", "DEN TB NO", "DAL PIT CLE", "NYG PIT CLE",
+		"PHI BAL CIN", "WAS BAL CIN", "GB TEN JAX", "MIN TEN JAX", "CHI IND HOU", "DET IND HOU",
+		"TB KC LAC", "NO KC LAC", "CAR LV DEN", "ATL LV DEN", "LAR NE NYJ", "SF BUF MIA",
+		"SEA NE NYJ", "ARI BUF MIA",
+	},
+	2021: {
+		"NE TEN JAX TB NO", "NYJ TEN JAX TB NO", "BUF IND HOU CAR ATL", "MIA IND HOU CAR ATL",
+		"PIT LV DEN CHI DET", "BAL KC LAC GB MIN", "CLE LV DEN CHI DET", "CIN KC LAC GB MIN",
+		"TEN BUF MIA SF ARI", "IND NE NYJ LAR SEA", "JAX BUF MIA SF ARI", "HOU NE NYJ LAR SEA",
+		"KC PIT CLE DAL NYG", "LV BAL CIN PHI WAS", "LAC PIT CLE DAL NYG", "DEN BAL CIN PHI WAS",
+		"DAL LV DEN CAR ATL", "NYG LV DEN CAR ATL", "PHI KC LAC TB NO", "WAS KC LAC TB NO",
+		"GB PIT CLE LAR SEA", "MIN PIT CLE LAR SEA", "CHI BAL CIN SF ARI", "DET BAL CIN SF ARI",
+		"TB BUF MIA DAL NYG", "NO BUF MIA DAL NYG", "CAR NE NYJ PHI WAS", "ATL NE NYJ PHI WAS",
+		"LAR TEN JAX CHI DET", "SF IND HOU GB MIN", "SEA TEN JAX CHI DET", "ARI IND HOU GB MIN",
+	},
+	2022: {
+		"NE BAL CIN CHI DET", "NYJ BAL CIN CHI DET", "BUF PIT CLE GB MIN", "MIA PIT CLE GB MIN",
+		"PIT NE NYJ TB NO", "BAL BUF MIA CAR ATL", "CLE NE NYJ TB NO", "CIN BUF MIA CAR ATL",
+		"TEN LV DEN DAL NYG", "IND KC LAC PHI WAS", "JAX LV DEN DAL NYG", "HOU KC LAC PHI WAS",
+		"KC TEN JAX LAR SEA", "LV IND HOU SF ARI", "LAC TEN JAX LAR SEA", "DEN IND HOU SF ARI",
+		"DAL IND HOU CHI DET", "NYG IND HOU CHI DET", "PHI TEN JAX GB MIN", "WAS TEN JAX GB MIN",
+		"GB NE NYJ DAL NYG", "MIN NE NYJ DAL NYG", "CHI BUF MIA PHI WAS", "DET BUF MIA PHI WAS",
+		"TB BAL CIN LAR SEA", "NO BAL CIN LAR SEA", "CAR PIT CLE SF ARI", "ATL PIT CLE SF ARI",
+		"LAR LV DEN CAR ATL", "SF KC LAC TB NO", "SEA LV DEN CAR ATL", "ARI KC LAC TB NO",
+	},
+	2023: {
+		"NE KC LAC PHI WAS", "NYJ KC LAC PHI WAS", "BUF LV DEN DAL NYG", "MIA LV DEN DAL NYG",
+		"PIT TEN JAX SF ARI", "BAL IND HOU LAR SEA", "CLE TEN JAX SF ARI", "CIN IND HOU LAR SEA",
+		"TEN BAL CIN CAR ATL", "IND PIT CLE TB NO", "JAX BAL CIN CAR ATL", "HOU PIT CLE TB NO",
+		"KC BUF MIA CHI DET", "LV NE NYJ GB MIN", "LAC BUF MIA CHI DET", "DEN NE NYJ GB MIN",
+		"DAL NE NYJ LAR SEA", "NYG NE NYJ LAR SEA", "PHI BUF MIA SF ARI", "WAS BUF MIA SF ARI",
+		"GB KC LAC TB NO", "MIN KC LAC TB NO", "CHI LV DEN CAR ATL", "DET LV DEN CAR ATL",
+		"TB TEN JAX CHI DET", "NO TEN JAX CHI DET", "CAR IND HOU GB MIN", "ATL IND HOU GB MIN",
+		"LAR PIT CLE PHI WAS", "SF BAL CIN DAL NYG", "SEA PIT CLE PHI WAS", "ARI BAL CIN DAL NYG",
+	},
+}
+
+// Matchup is a game of a season before it has been given a week
+type Matchup struct {
+	Home Team
+	Away Team
+}
+
+// ConferenceDivisions returns the four divisions of the conference, in the order East, North, South, West
+func ConferenceDivisions(conference string) []string {
+	out := make([]string, 0, 4)
+	for _, t := range NFLTeams {
+		if t.Conference == conference && !slices.Contains(out, t.Division) {
+			out = append(out, t.Division)
+		}
+	}
+	return out
+}
+
+// divisionIndex returns the conference and position of a division within it
+func divisionIndex(division string) (string, int) {
+	for _, conf := range Conferences {
+		if i := slices.Index(ConferenceDivisions(conf), division); i >= 0 {
+			return conf, i
+		}
+	}
+	return "", -1
+}
+
+// mod is the remainder of a by b, never negative, so the tables work for any season
+func mod(a, b int) int {
+	return ((a % b) + b) % b
+}
+
+// IntraConferenceDivision returns the division of the same conference whose teams the division plays in the season
+func IntraConferenceDivision(division string, season int) string {
+	conf, i := divisionIndex(division)
+	if i < 0 {
+		return ""
+	}
+	return ConferenceDivisions(conf)[intraRotation[mod(season, 3)][i]]
+}
+
+// InterConferenceDivision returns the division of the other conference whose teams the division plays in the season
+func InterConferenceDivision(division string, season int) string {
+	conf, i := divisionIndex(division)
+	if i < 0 {
+		return ""
+	}
+	row := interRotation[mod(season, 4)]
+	if conf == AFC {
+		return ConferenceDivisions(NFC)[row[i]]
+	}
+	return ConferenceDivisions(AFC)[slices.Index(row[:], i)]
+}
+
+// SeventeenthGameDivision returns the division of the other conference the division plays its seventeenth games against,
+// the one it played two seasons before
+func SeventeenthGameDivision(division string, season int) string {
+	return InterConferenceDivision(division, season-2)
+}
+
+// SamePlaceDivisions returns the two divisions of the same conference whose teams that finished in the same place
+// the division's teams play in the season. The division hosts the first and visits the second
+func SamePlaceDivisions(division string, season int) (string, string) {
+	conf, i := divisionIndex(division)
+	if i < 0 {
+		return "", ""
+	}
+
+	// The same place games of a conference form a cycle through its four divisions, each hosting the next: the first
+	// division hosts the rotation partner of the lowest division it does not play in the rotation, which hosts the
+	// first division's partner, which hosts that lowest division, which hosts the first division
+	partner := intraRotation[mod(season, 3)]
+	next := 1
+	if partner[0] == 1 {
+		next = 2
+	}
+	cycle := []int{0, partner[next], partner[0], next}
+
+	divs := ConferenceDivisions(conf)
+	at := slices.Index(cycle, i)
+	host, visit := divs[cycle[(at+1)%4]], divs[cycle[(at+3)%4]]
+	// The NFC goes round the cycle the other way
+	if conf == NFC {
+		return visit, host
+	}
+	return host, visit
+}
+
+// rotationHome reports whether a hosts b in their rotation game of the season, the two meeting every cycle seasons
+func rotationHome(a, b Team, season, cycle int) bool {
+	for last, hosts := range rotationHosts {
+		if mod(season-last, cycle) != 0 {
+			continue
+		}
+		// The site has been reversed once for every meeting since
+		reversed := mod((season-last)/cycle, 2) == 1
+		for _, h := range hosts {
+			f := strings.Fields(h)
+			if f[0] == a.Abbreviation && slices.Contains(f[1:], b.Abbreviation) {
+				return !reversed
+			}
+			if f[0] == b.Abbreviation && slices.Contains(f[1:], a.Abbreviation) {
+				return reversed
+			}
+		}
+	}
+	return false
+}
+
+// Places returns each team's place in its division from the given order, teams listed earlier finishing higher
+func Places(teams []Team) map[string]int {
+	places := make(map[string]int)
+	count := make(map[string]int)
+	for _, t := range teams {
+		count[t.Division]++
+		places[t.Name] = count[t.Division]
+	}
+	return places
+}
+
+// SeasonMatchups returns the 272 games of a season under the scheduling formula
+// places holds each team's finish in its division the season before, 1 to 4, keyed by team name. When nil the
+// teams are placed in the order they are listed in their division
+func SeasonMatchups(season int, places map[string]int) ([]Matchup, error) {
+	if places == nil {
+		places = Places(NFLTeams)
+	}
+	// byPlace holds each division's teams indexed by place - 1
+	byPlace := make(map[string][]Team)
+	for _, div := range Divisions {
+		byPlace[div] = make([]Team, 4)
+	}
+	for _, t := range NFLTeams {
+		p := places[t.Name]
+		if p < 1 || p > 4 {
+			return nil, fmt.Errorf("%s has no place in its division between 1 and 4", t.Name)
+		}
+		if byPlace[t.Division][p-1].Name != "" {
+			return nil, fmt.Errorf("%s and %s both finished in place %d", byPlace[t.Division][p-1].Name, t.Name, p)
+		}
+		byPlace[t.Division][p-1] = t
+	}
+
+	out := make([]Matchup, 0, 272)
+	for _, div := range Divisions {
+		teams := byPlace[div]
+		conf, _ := divisionIndex(div)
+
+		// Division games, home and away
+		for _, home := range teams {
+			for _, away := range teams {
+				if home != away {
+					out = append(out, Matchup{Home: home, Away: away})
+				}
+			}
+		}
+
+		// Each pair of divisions is added once, by the first of them in Divisions, at the sites of rotationHosts
+		rotation := func(other string, cycle int) {
+			if slices.Index(Divisions, other) < slices.Index(Divisions, div) {
+				return
+			}
+			for _, a := range teams {
+				for _, b := range byPlace[other] {
+					if rotationHome(a, b, season, cycle) {
+						out = append(out, Matchup{Home: a, Away: b})
+					} else {
+						out = append(out, Matchup{Home: b, Away: a})
+					}
+				}
+			}
+		}
+		rotation(IntraConferenceDivision(div, season), 3)
+		rotation(InterConferenceDivision(div, season), 4)
+
+		// Same place games, adding only the one each team hosts so every game is added once
+		hosted, _ := SamePlaceDivisions(div, season)
+		for i, t := range teams {
+			out = append(out, Matchup{Home: t, Away: byPlace[hosted][i]})
+		}
+
+		// The seventeenth game is added by the hosting conference
+		if (conf == AFC) == (mod(season, 2) == 1) {
+			other := SeventeenthGameDivision(div, season)
+			for i, t := range teams {
+				out = append(out, Matchup{Home: t, Away: byPlace[other][i]})
+			}
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,106 @@
+package team
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+// TestRotation checks the rotations against the 2024 season
+func TestRotation(t *testing.T) {
+	if got := IntraConferenceDivision(AFCEast, 2024); got != AFCSouth {
+		t.Errorf("expected the AFC East to play the AFC South, got %s", got)
+	}
+	if got := InterConferenceDivision(AFCEast, 2024); got != NFCWest {
+		t.Errorf("expected the AFC East to play the NFC West, got %s", got)
+	}
+	if got := InterConferenceDivision(NFCEast, 2024); got != AFCNorth {
+		t.Errorf("expected the NFC East to play the AFC North, got %s", got)
+	}
+	if got := SeventeenthGameDivision(AFCNorth, 2024); got != NFCSouth {
+		t.Errorf("expected the AFC North's seventeenth games against the NFC South, got %s", got)
+	}
+	// The Bills hosted the Chiefs and visited the Ravens
+	if host, visit := SamePlaceDivisions(AFCEast, 2024); host != AFCWest || visit != AFCNorth {
+		t.Errorf("expected the AFC East to host the AFC West and visit the AFC North, got %s and %s", host, visit)
+	}
+	// The Lions hosted the Buccaneers and visited the Cowboys
+	if host, visit := SamePlaceDivisions(NFCNorth, 2024); host != NFCSouth || visit != NFCEast {
+		t.Errorf("expected the NFC North to host the NFC South and visit the NFC East, got %s and %s", host, visit)
+	}
+}
+
+func TestSeasonMatchups(t *testing.T) {
+	for season := 2021; season <= 2032; season++ {
+		matchups, err := SeasonMatchups(season, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(matchups) != 272 {
+			t.Fatalf("%d: expected 272 games, got %d", season, len(matchups))
+		}
+
+		games := make(map[string]int)
+		home := make(map[string]int)
+		for _, m := range matchups {
+			games[m.Home.Name]++
+			games[m.Away.Name]++
+			home[m.Home.Name]++
+		}
+		for _, tm := range NFLTeams {
+			if games[tm.Name] != 17 {
+				t.Errorf("%d: %s plays %d games", season, tm.Name, games[tm.Name])
+			}
+			// Nine home games for the conference hosting the seventeenth game, eight for the other
+			want := 8
+			if (tm.Conference == AFC) == (season%2 == 1) {
+				want = 9
+			}
+			if home[tm.Name] != want {
+				t.Errorf("%d: %s has %d home games, expected %d", season, tm.Name, home[tm.Name], want)
+			}
+		}
+	}
+
+	places := Places(NFLTeams)
+	places[BuffaloBills.Name] = places[NewEnglandPatriots.Name]
+	if _, err := SeasonMatchups(2024, places); err == nil {
+		t.Errorf("expected an error with two teams in the same place")
+	}
+}
+
+// TestRotationSites checks the sites of the rotation games against the 2024 season, the first one rotationHosts
+// does not list
+func TestRotationSites(t *testing.T) {
+	// Each team followed by the rotation games it hosted
+	hosted := []string{
+		"NE IND HOU LAR SEA", "NYJ IND HOU LAR SEA", "BUF TEN JAX SF ARI", "MIA TEN JAX SF ARI",
+		"PIT KC LAC DAL NYG", "BAL LV DEN PHI WAS", "CLE KC LAC DAL NYG", "CIN LV DEN PHI WAS",
+		"TEN NE NYJ GB MIN", "IND BUF MIA CHI DET", "JAX NE NYJ GB MIN", "HOU BUF MIA CHI DET",
+		"KC BAL CIN TB NO", "LV PIT CLE CAR ATL", "LAC BAL CIN TB NO", "DEN PIT CLE CAR ATL",
+		"DAL BAL CIN TB NO", "NYG BAL CIN TB NO", "PHI PIT CLE CAR ATL", "WAS PIT CLE CAR ATL",
+		"GB IND HOU SF ARI", "MIN IND HOU SF ARI", "CHI TEN JAX LAR SEA", "DET TEN JAX LAR SEA",
+		"TB LV DEN PHI WAS", "NO LV DEN PHI WAS", "CAR KC LAC DAL NYG", "ATL KC LAC DAL NYG",
+		"LAR BUF MIA GB MIN", "SF NE NYJ CHI DET", "SEA BUF MIA GB MIN", "ARI NE NYJ CHI DET",
+	}
+
+	matchups, err := SeasonMatchups(2024, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rotation := 0
+	for _, m := range matchups {
+		div := m.Home.Division
+		if m.Away.Division != IntraConferenceDivision(div, 2024) && m.Away.Division != InterConferenceDivision(div, 2024) {
+			continue
+		}
+		rotation++
+		i := slices.IndexFunc(hosted, func(h string) bool { return strings.HasPrefix(h, m.Home.Abbreviation+" ") })
+		if !slices.Contains(strings.Fields(hosted[i])[1:], m.Away.Abbreviation) {
+			t.Errorf("expected %s to host %s", m.Away.Abbreviation, m.Home.Abbreviation)
+		}
+	}
+	if rotation != 128 {
+		t.Errorf("expected 128 rotation games, got %d", rotation)
+	}
+}
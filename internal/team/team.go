@@ -2,15 +2,17 @@ package team
 
 import (
 	"slices"
-	"time"
-
-	"golang.org/x/exp/rand"
+	"strings"
 )
 
 type Team struct {
-	Name       string `json:"name"` // Could split this into struct containing city + team, but let's keep it simple for now
-	Conference string `json:"conference"`
-	Division   string `json:"division"`
+	Name         string `json:"name"` // Could split this into struct containing city + team, but let's keep it simple for now
+	Abbreviation string `json:"abbreviation"`
+	Conference   string `json:"conference"`
+	Division     string `json:"division"`
+
+	// Color is the team's primary color, as a CSS hex color
+	Color string `json:"color"`
 }
 
 const (
@@ -32,195 +34,259 @@ const (
 // Definitions for all teams, divisons, and conferences
 var (
 	NewEnglandPatriots = Team{
-		Name:       "New England Patriots",
-		Conference: AFC,
-		Division:   AFCEast,
+		Name:         "New England Patriots",
+		Abbreviation: "NE",
+		Color:        "#002244",
+		Conference:   AFC,
+		Division:     AFCEast,
 	}
 
 	NewYorkJets = Team{
-		Name:       "New York Jets",
-		Conference: AFC,
-		Division:   AFCEast,
+		Name:         "New York Jets",
+		Abbreviation: "NYJ",
+		Color:        "#125740",
+		Conference:   AFC,
+		Division:     AFCEast,
 	}
 
 	BuffaloBills = Team{
-		Name:       "Buffalo Bills",
-		Conference: AFC,
-		Division:   AFCEast,
+		Name:         "Buffalo Bills",
+		Abbreviation: "BUF",
+		Color:        "#00338D",
+		Conference:   AFC,
+		Division:     AFCEast,
 	}
 
 	MiamiDolphins = Team{
-		Name:       "Miami Dolphins",
-		Conference: AFC,
-		Division:   AFCEast,
+		Name:         "Miami Dolphins",
+		Abbreviation: "MIA",
+		Color:        "#008E97",
+		Conference:   AFC,
+		Division:     AFCEast,
 	}
 
 	PittsburghSteelers = Team{
-		Name:       "Pittsburgh Steelers",
-		Conference: AFC,
-		Division:   AFCNorth,
+		Name:         "Pittsburgh Steelers",
+		Abbreviation: "PIT",
+		Color:        "#FFB612",
+		Conference:   AFC,
+		Division:     AFCNorth,
 	}
 
 	BaltimoreRavens = Team{
-		Name:       "Baltimore Ravens",
-		Conference: AFC,
-		Division:   AFCNorth,
+		Name:         "Baltimore Ravens",
+		Abbreviation: "BAL",
+		Color:        "#241773",
+		Conference:   AFC,
+		Division:     AFCNorth,
 	}
 
 	ClevelandBrowns = Team{
-		Name:       "Cleveland Browns",
-		Conference: AFC,
-		Division:   AFCNorth,
+		Name:         "Cleveland Browns",
+		Abbreviation: "CLE",
+		Color:        "#311D00",
+		Conference:   AFC,
+		Division:     AFCNorth,
 	}
 
 	CincinnatiBengals = Team{
-		Name:       "Cincinnati Bengals",
-		Conference: AFC,
-		Division:   AFCNorth,
+		Name:         "Cincinnati Bengals",
+		Abbreviation: "CIN",
+		Color:        "#FB4F14",
+		Conference:   AFC,
+		Division:     AFCNorth,
 	}
 
 	TennesseeTitans = Team{
-		Name:       "Tennessee Titans",
-		Conference: AFC,
-		Division:   AFCSouth,
+		Name:         "Tennessee Titans",
+		Abbreviation: "TEN",
+		Color:        "#4B92DB",
+		Conference:   AFC,
+		Division:     AFCSouth,
 	}
 
 	IndianapolisColts = Team{
-		Name:       "Indianapolis Colts",
-		Conference: AFC,
-		Division:   AFCSouth,
+		Name:         "Indianapolis Colts",
+		Abbreviation: "IND",
+		Color:        "#002C5F",
+		Conference:   AFC,
+		Division:     AFCSouth,
 	}
 
 	JacksonvilleJaguars = Team{
-		Name:       "Jacksonville Jaguars",
-		Conference: AFC,
-		Division:   AFCSouth,
+		Name:         "Jacksonville Jaguars",
+		Abbreviation: "JAX",
+		Color:        "#006778",
+		Conference:   AFC,
+		Division:     AFCSouth,
 	}
 
 	HoustonTexans = Team{
-		Name:       "Houston Texans",
-		Conference: AFC,
-		Division:   AFCSouth,
+		Name:         "Houston Texans",
+		Abbreviation: "HOU",
+		Color:        "#03202F",
+		Conference:   AFC,
+		Division:     AFCSouth,
 	}
 
 	KansasCityChiefs = Team{
-		Name:       "Kansas City Chiefs",
-		Conference: AFC,
-		Division:   AFCWest,
+		Name:         "Kansas City Chiefs",
+		Abbreviation: "KC",
+		Color:        "#E31837",
+		Conference:   AFC,
+		Division:     AFCWest,
 	}
 
 	LasVegasRaiders = Team{
-		Name:       "Las Vegas Raiders",
-		Conference: AFC,
-		Division:   AFCWest,
+		Name:         "Las Vegas Raiders",
+		Abbreviation: "LV",
+		Color:        "#A5ACAF",
+		Conference:   AFC,
+		Division:     AFCWest,
 	}
 
 	LosAngelesChargers = Team{
-		Name:       "Los Angeles Chargers",
-		Conference: AFC,
-		Division:   AFCWest,
+		Name:         "Los Angeles Chargers",
+		Abbreviation: "LAC",
+		Color:        "#0080C6",
+		Conference:   AFC,
+		Division:     AFCWest,
 	}
 
 	DenverBroncos = Team{
-		Name:       "Denver Broncos",
-		Conference: AFC,
-		Division:   AFCWest,
+		Name:         "Denver Broncos",
+		Abbreviation: "DEN",
+		Color:        "#FB4F14",
+		Conference:   AFC,
+		Division:     AFCWest,
 	}
 
 	DallasCowboys = Team{
-		Name:       "Dallas Cowboys",
-		Conference: NFC,
-		Division:   NFCEast,
+		Name:         "Dallas Cowboys",
+		Abbreviation: "DAL",
+		Color:        "#003594",
+		Conference:   NFC,
+		Division:     NFCEast,
 	}
 
 	NewYorkGiants = Team{
-		Name:       "New York Giants",
-		Conference: NFC,
-		Division:   NFCEast,
+		Name:         "New York Giants",
+		Abbreviation: "NYG",
+		Color:        "#0B2265",
+		Conference:   NFC,
+		Division:     NFCEast,
 	}
 
 	PhiladelphiaEagles = Team{
-		Name:       "Philadelphia Eagles",
-		Conference: NFC,
-		Division:   NFCEast,
+		Name:         "Philadelphia Eagles",
+		Abbreviation: "PHI",
+		Color:        "#004C54",
+		Conference:   NFC,
+		Division:     NFCEast,
 	}
 
 	WashingtonCommanders = Team{
-		Name:       "Washington Commanders",
-		Conference: NFC,
-		Division:   NFCEast,
+		Name:         "Washington Commanders",
+		Abbreviation: "WAS",
+		Color:        "#5A1414",
+		Conference:   NFC,
+		Division:     NFCEast,
 	}
 
 	GreenBayPackers = Team{
-		Name:       "Green Bay Packers",
-		Conference: NFC,
-		Division:   NFCNorth,
+		Name:         "Green Bay Packers",
+		Abbreviation: "GB",
+		Color:        "#203731",
+		Conference:   NFC,
+		Division:     NFCNorth,
 	}
 
 	MinnesotaVikings = Team{
-		Name:       "Minnesota Vikings",
-		Conference: NFC,
-		Division:   NFCNorth,
+		Name:         "Minnesota Vikings",
+		Abbreviation: "MIN",
+		Color:        "#4F2683",
+		Conference:   NFC,
+		Division:     NFCNorth,
 	}
 
 	ChicagoBears = Team{
-		Name:       "Chicago Bears",
-		Conference: NFC,
-		Division:   NFCNorth,
+		Name:         "Chicago Bears",
+		Abbreviation: "CHI",
+		Color:        "#0B162A",
+		Conference:   NFC,
+		Division:     NFCNorth,
 	}
 
 	DetroitLions = Team{
-		Name:       "Detroit Lions",
-		Conference: NFC,
-		Division:   NFCNorth,
+		Name:         "Detroit Lions",
+		Abbreviation: "DET",
+		Color:        "#0076B6",
+		Conference:   NFC,
+		Division:     NFCNorth,
 	}
 
 	TampaBayBuccaneers = Team{
-		Name:       "Tampa Bay Buccaneers",
-		Conference: NFC,
-		Division:   NFCSouth,
+		Name:         "Tampa Bay Buccaneers",
+		Abbreviation: "TB",
+		Color:        "#D50A0A",
+		Conference:   NFC,
+		Division:     NFCSouth,
 	}
 
 	NewOrleansSaints = Team{
-		Name:       "New Orleans Saints",
-		Conference: NFC,
-		Division:   NFCSouth,
+		Name:         "New Orleans Saints",
+		Abbreviation: "NO",
+		Color:        "#D3BC8D",
+		Conference:   NFC,
+		Division:     NFCSouth,
 	}
 
 	CarolinaPanthers = Team{
-		Name:       "Carolina Panthers",
-		Conference: NFC,
-		Division:   NFCSouth,
+		Name:         "Carolina Panthers",
+		Abbreviation: "CAR",
+		Color:        "#0085CA",
+		Conference:   NFC,
+		Division:     NFCSouth,
 	}
 
 	AtlantaFalcons = Team{
-		Name:       "Atlanta Falcons",
-		Conference: NFC,
-		Division:   NFCSouth,
+		Name:         "Atlanta Falcons",
+		Abbreviation: "ATL",
+		Color:        "#A71930",
+		Conference:   NFC,
+		Division:     NFCSouth,
 	}
 
 	LosAngelesRams = Team{
-		Name:       "Los Angeles Rams",
-		Conference: NFC,
-		Division:   NFCWest,
+		Name:         "Los Angeles Rams",
+		Abbreviation: "LAR",
+		Color:        "#003594",
+		Conference:   NFC,
+		Division:     NFCWest,
 	}
 
 	SanFrancisco49ers = Team{
-		Name:       "San Francisco 49ers",
-		Conference: NFC,
-		Division:   NFCWest,
+		Name:         "San Francisco 49ers",
+		Abbreviation: "SF",
+		Color:        "#AA0000",
+		Conference:   NFC,
+		Division:     NFCWest,
 	}
 
 	SeattleSeahawks = Team{
-		Name:       "Seattle Seahawks",
-		Conference: NFC,
-		Division:   NFCWest,
+		Name:         "Seattle Seahawks",
+		Abbreviation: "SEA",
+		Color:        "#69BE28",
+		Conference:   NFC,
+		Division:     NFCWest,
 	}
 
 	ArizonaCardinals = Team{
-		Name:       "Arizona Cardinals",
-		Conference: NFC,
-		Division:   NFCWest,
+		Name:         "Arizona Cardinals",
+		Abbreviation: "ARI",
+		Color:        "#97233F",
+		Conference:   NFC,
+		Division:     NFCWest,
 	}
 
 	AFCEastTeams  = []Team{NewEnglandPatriots, NewYorkJets, BuffaloBills, MiamiDolphins}
@@ -314,6 +380,39 @@ func DisplayNameToTeam(displayName string) Team {
 	}
 }
 
+// Lookup finds a team by its full name, abbreviation or nickname, ignoring case
+// For example "Buffalo Bills", "BUF" and "bills" all find the Buffalo Bills
+func Lookup(name string) (Team, bool) {
+	for _, t := range NFLTeams {
+		nickname := t.Name[strings.LastIndex(t.Name, " ")+1:]
+		if strings.EqualFold(name, t.Name) || strings.EqualFold(name, t.Abbreviation) || strings.EqualFold(name, nickname) {
+			return t, true
+		}
+	}
+	return Team{}, false
+}
+
+// LookupConference finds a conference by its full name or abbreviation, ignoring case
+func LookupConference(name string) (string, bool) {
+	for _, conf := range Conferences {
+		if strings.EqualFold(name, conf) || strings.EqualFold(name, ConferenceAbbreviation(conf)) {
+			return conf, true
+		}
+	}
+	return "", false
+}
+
+// ConferenceAbbreviation returns AFC or NFC for the given conference
+func ConferenceAbbreviation(conference string) string {
+	switch conference {
+	case AFC:
+		return "AFC"
+	case NFC:
+		return "NFC"
+	}
+	return ""
+}
+
 func SameDivision(t1, t2 string) bool {
 	return DisplayNameToTeam(t1).Division == DisplayNameToTeam(t2).Division
 }
@@ -330,48 +429,3 @@ func Names(teams []Team) string {
 
 	return out
 }
-
-// TODO: Potential enhancement to GetRandomTeams()
-// TeamGen is used to generate a collection of teams based on certain criteria
-// type TeamGen struct {
-// 	// TODO mustHave, if we want it
-// 	canHave    GenCriteria
-// 	cannotHave GenCriteria
-// }
-
-// type GenCriteria struct {
-// 	teams      []Team
-// 	divisions  []string
-// 	conference string
-// }
-
-// func (tg *TeamGen) GenerateRandomTeams(count int) []Team {
-
-// }
-
-func GetRandomTeams(count int, noTeams []Team, noDivs []string, noConf string) []Team {
-	rand.Seed(uint64(time.Now().UnixNano()))
-	out := make([]Team, 0, count)
-
-	// TODO: Better to make these map[string]interface{}?
-	seenTeams := make(map[string]bool)
-	seenDivs := make(map[string]bool)
-
-	for _, team := range noTeams {
-		seenTeams[team.Name] = true
-	}
-	for _, div := range noDivs {
-		seenDivs[div] = true
-	}
-
-	for len(out) < count {
-		randomTeam := NFLTeams[rand.Intn(len(NFLTeams))]
-		if !seenTeams[randomTeam.Name] && !seenDivs[randomTeam.Division] && randomTeam.Conference != noConf {
-			out = append(out, randomTeam)
-			// Update seenTeams so we don't have duplicates
-			seenTeams[randomTeam.Name] = true
-		}
-	}
-
-	return out
-}
@@ -0,0 +1,101 @@
+package team
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+)
+
+// GenCriteria describes a set of teams by the teams themselves, their divisions or their conferences
+type GenCriteria struct {
+	Teams       []Team
+	Divisions   []string
+	Conferences []string
+}
+
+func (c GenCriteria) empty() bool {
+	return len(c.Teams) == 0 && len(c.Divisions) == 0 && len(c.Conferences) == 0
+}
+
+// matches reports whether the team is listed, or is in a listed division or conference
+func (c GenCriteria) matches(t Team) bool {
+	return slices.Contains(c.Teams, t) || slices.Contains(c.Divisions, t.Division) || slices.Contains(c.Conferences, t.Conference)
+}
+
+// TeamGen picks random collections of teams meeting its criteria
+//
+// Every MustHave team is picked, along with at least one team from each MustHave division and conference.
+// The rest are picked from the CanHave teams, or from any team when CanHave is empty. CannotHave teams are never picked
+type TeamGen struct {
+	MustHave   GenCriteria
+	CanHave    GenCriteria
+	CannotHave GenCriteria
+
+	rng *rand.Rand
+}
+
+// NewTeamGen returns a generator whose picks are decided by the seed, so the same seed and criteria always give
+// the same teams
+func NewTeamGen(seed uint64) *TeamGen {
+	return &TeamGen{rng: rand.New(rand.NewPCG(seed, seed))}
+}
+
+// Generate picks count teams, returning an error when the criteria cannot be met with that many
+// MustHave teams come first in the order given, the rest in random order
+func (tg *TeamGen) Generate(count int) ([]Team, error) {
+	out := make([]Team, 0, count)
+	for _, t := range tg.MustHave.Teams {
+		if tg.CannotHave.matches(t) {
+			return nil, fmt.Errorf("%s must be picked but cannot be", t.Name)
+		}
+		if !slices.Contains(out, t) {
+			out = append(out, t)
+		}
+	}
+
+	// Cover each required division, then each required conference, with a random team not already covering it
+	for _, div := range tg.MustHave.Divisions {
+		if err := tg.cover(&out, func(t Team) bool { return t.Division == div }, div); err != nil {
+			return nil, err
+		}
+	}
+	for _, conf := range tg.MustHave.Conferences {
+		if err := tg.cover(&out, func(t Team) bool { return t.Conference == conf }, conf); err != nil {
+			return nil, err
+		}
+	}
+	if len(out) > count {
+		return nil, fmt.Errorf("%d teams are needed to meet the criteria, but only %d were asked for", len(out), count)
+	}
+
+	candidates := tg.candidates(out, func(t Team) bool { return tg.CanHave.empty() || tg.CanHave.matches(t) })
+	if len(out)+len(candidates) < count {
+		return nil, fmt.Errorf("only %d teams meet the criteria, %d were asked for", len(out)+len(candidates), count)
+	}
+	tg.rng.Shuffle(len(candidates), func(i, j int) { candidates[i], candidates[j] = candidates[j], candidates[i] })
+	return append(out, candidates[:count-len(out)]...), nil
+}
+
+// cover adds a random allowed team matching in to out, unless a team in out already matches
+func (tg *TeamGen) cover(out *[]Team, in func(Team) bool, name string) error {
+	if slices.ContainsFunc(*out, in) {
+		return nil
+	}
+	candidates := tg.candidates(*out, in)
+	if len(candidates) == 0 {
+		return fmt.Errorf("no team from the %s can be picked", name)
+	}
+	*out = append(*out, candidates[tg.rng.IntN(len(candidates))])
+	return nil
+}
+
+// candidates returns the teams matching keep that are not picked yet and not ruled out, in league order
+func (tg *TeamGen) candidates(picked []Team, keep func(Team) bool) []Team {
+	out := make([]Team, 0)
+	for _, t := range NFLTeams {
+		if keep(t) && !tg.CannotHave.matches(t) && !slices.Contains(picked, t) {
+			out = append(out, t)
+		}
+	}
+	return out
+}
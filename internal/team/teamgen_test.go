@@ -0,0 +1,55 @@
+package team
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestTeamGenMeetsCriteria(t *testing.T) {
+	tg := NewTeamGen(7)
+	tg.MustHave = GenCriteria{Teams: []Team{NewEnglandPatriots}, Divisions: []string{NFCWest}}
+	tg.CanHave = GenCriteria{Conferences: []string{AFC}}
+	tg.CannotHave = GenCriteria{Divisions: []string{AFCNorth}, Teams: []Team{KansasCityChiefs}}
+
+	got, err := tg.Generate(6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 6 || got[0] != NewEnglandPatriots || got[1].Division != NFCWest {
+		t.Fatalf("expected the Patriots then an NFC West team first, got %s", Names(got))
+	}
+	for _, tm := range got[2:] {
+		if tm.Conference != AFC || tm.Division == AFCNorth || tm == KansasCityChiefs {
+			t.Errorf("%s does not meet the criteria", tm.Name)
+		}
+	}
+
+	// The same seed always gives the same teams
+	again := NewTeamGen(7)
+	again.MustHave, again.CanHave, again.CannotHave = tg.MustHave, tg.CanHave, tg.CannotHave
+	if other, _ := again.Generate(6); !slices.Equal(other, got) {
+		t.Errorf("expected %s from the same seed, got %s", Names(got), Names(other))
+	}
+}
+
+func TestTeamGenUnsatisfiable(t *testing.T) {
+	tests := []struct {
+		name  string
+		gen   TeamGen
+		count int
+	}{
+		{"too many teams", TeamGen{CannotHave: GenCriteria{Divisions: []string{AFCEast, AFCNorth, AFCSouth}}}, 21},
+		{"must and cannot", TeamGen{MustHave: GenCriteria{Teams: []Team{BuffaloBills}}, CannotHave: GenCriteria{Divisions: []string{AFCEast}}}, 2},
+		{"no team left in a division", TeamGen{MustHave: GenCriteria{Divisions: []string{NFCNorth}}, CannotHave: GenCriteria{Conferences: []string{NFC}}}, 2},
+		{"fewer than must have", TeamGen{MustHave: GenCriteria{Divisions: []string{AFCEast, NFCEast}}}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tg := NewTeamGen(1)
+			tg.MustHave, tg.CanHave, tg.CannotHave = tt.gen.MustHave, tt.gen.CanHave, tt.gen.CannotHave
+			if got, err := tg.Generate(tt.count); err == nil {
+				t.Errorf("expected an error, got %s", Names(got))
+			}
+		})
+	}
+}
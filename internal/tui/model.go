@@ -0,0 +1,190 @@
+package tui
+
+import (
+	"nfl-app/internal/entry"
+	"nfl-app/internal/entrysort"
+	"nfl-app/internal/schedule"
+	"nfl-app/internal/standings"
+	"nfl-app/internal/team"
+)
+
+// Key is a keyboard action understood by the model
+type Key int
+
+const (
+	KeyNone Key = iota
+	KeyUp
+	KeyDown
+	KeyHomeWin
+	KeyAwayWin
+	KeyTie
+	KeyClear
+	KeyClearAll
+	KeyConference
+	KeyTrace
+	KeyNextGroup
+	KeyPrevGroup
+	KeyBack
+	KeyQuit
+)
+
+// Model is the state of the terminal UI: the season, the picks made so far and what is on screen
+// It does no I/O, so it can be driven by any input and rendered anywhere
+type Model struct {
+	base  schedule.Schedule
+	games []schedule.ScheduledGame
+
+	// picks holds the chosen outcome of each remaining game, by index into games
+	picks map[int]standings.Outcome
+
+	cursor     int
+	conference int
+
+	// tracing is true while the tiebreaker panel is open, showing the trace of the selected tied group
+	tracing bool
+	group   int
+
+	standings standings.Standings
+
+	// groups holds the teams of the current conference that are tied on win percentage
+	groups [][]entry.Entry
+	trace  *entrysort.Trace
+	err    error
+}
+
+// New returns a model for the given season, with no picks made
+func New(sched schedule.Schedule) (*Model, error) {
+	m := &Model{
+		base:  sched,
+		games: sched.RemainingGames(),
+		picks: make(map[int]standings.Outcome),
+	}
+	if err := m.recompute(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Update applies a key press and reports whether the UI should quit
+func (m *Model) Update(k Key) bool {
+	if m.tracing {
+		switch k {
+		case KeyNextGroup, KeyDown:
+			m.selectGroup(m.group + 1)
+		case KeyPrevGroup, KeyUp:
+			m.selectGroup(m.group - 1)
+		case KeyTrace, KeyBack:
+			m.tracing = false
+		case KeyQuit:
+			return true
+		}
+		return false
+	}
+
+	switch k {
+	case KeyUp:
+		m.cursor = max(m.cursor-1, 0)
+	case KeyDown:
+		m.cursor = min(m.cursor+1, max(len(m.games)-1, 0))
+	case KeyHomeWin:
+		m.pick(standings.HomeWin)
+	case KeyAwayWin:
+		m.pick(standings.AwayWin)
+	case KeyTie:
+		m.pick(standings.Tie)
+	case KeyClear:
+		if _, ok := m.picks[m.cursor]; ok {
+			delete(m.picks, m.cursor)
+			m.err = m.recompute()
+		}
+	case KeyClearAll:
+		if len(m.picks) > 0 {
+			clear(m.picks)
+			m.err = m.recompute()
+		}
+	case KeyConference:
+		m.conference = (m.conference + 1) % len(team.Conferences)
+		m.err = m.recompute()
+	case KeyTrace:
+		if len(m.groups) > 0 {
+			m.tracing = true
+			m.selectGroup(m.group)
+		}
+	case KeyQuit:
+		return true
+	}
+	return false
+}
+
+// pick sets the outcome of the game under the cursor and moves to the next game
+func (m *Model) pick(o standings.Outcome) {
+	if len(m.games) == 0 {
+		return
+	}
+	m.picks[m.cursor] = o
+	m.err = m.recompute()
+	m.cursor = min(m.cursor+1, len(m.games)-1)
+}
+
+// recompute plays the picks on a copy of the season and reseeds it
+// Only ranking and seeding are done, not clinching, so this is quick enough to run on every key press
+func (m *Model) recompute() error {
+	sched := m.base.Clone()
+	for i, o := range m.picks {
+		sg := m.games[i]
+		if err := sched.Play(sg.Week, o.Result(sg.Game)); err != nil {
+			return err
+		}
+	}
+
+	st, err := standings.Rank(sched)
+	if err != nil {
+		return err
+	}
+	m.standings = st
+
+	// Tied groups of the conference on screen, best first
+	winPct := make(map[string]float64)
+	confEntries := st.Conference(m.currentConference())
+	for _, e := range confEntries {
+		winPct[e.Team.Name] = e.Stats.Record.WinPercentage()
+	}
+	m.groups = m.groups[:0]
+	for _, group := range entry.GroupEntries(confEntries, winPct) {
+		if len(group) > 1 {
+			m.groups = append(m.groups, group)
+		}
+	}
+
+	m.group = 0
+	m.tracing = false
+	m.trace = nil
+	return nil
+}
+
+// selectGroup shows the trace for the tied group with the given index, wrapping around
+func (m *Model) selectGroup(i int) {
+	if len(m.groups) == 0 {
+		return
+	}
+	m.group = (i%len(m.groups) + len(m.groups)) % len(m.groups)
+
+	_, trace, err := entrysort.SortEntriesTrace(m.groups[m.group], m.standings.TeamSchedules)
+	m.trace, m.err = trace, err
+}
+
+func (m *Model) currentConference() string {
+	return team.Conferences[m.conference]
+}
+
+// groupOf returns the number (from 1) of the tied group the team is in, or 0 if it is not tied
+func (m *Model) groupOf(teamname string) int {
+	for i, group := range m.groups {
+		for _, e := range group {
+			if e.Team.Name == teamname {
+				return i + 1
+			}
+		}
+	}
+	return 0
+}
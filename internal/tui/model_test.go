@@ -0,0 +1,53 @@
+package tui
+
+import (
+	"nfl-app/internal/game"
+	"nfl-app/internal/schedule"
+	"nfl-app/internal/team"
+	"strings"
+	"testing"
+)
+
+func TestModelPicks(t *testing.T) {
+	sched := schedule.NewSchedule()
+	sched.AddGame(1, game.Game{Home: team.BuffaloBills.Name, Away: team.MiamiDolphins.Name,
+		Winner: team.BuffaloBills.Name, Loser: team.MiamiDolphins.Name, PtsWin: 20, PtsLose: 10})
+	sched.AddRemaining(2, game.Game{Home: team.MiamiDolphins.Name, Away: team.BuffaloBills.Name})
+
+	m, err := New(sched)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	seedOf := func(name string) int {
+		e, _ := m.standings.Entry(name)
+		return e.Stats.Seed
+	}
+	if seedOf(team.BuffaloBills.Name) != 1 {
+		t.Fatalf("expected the Bills to be the 1 seed before any picks")
+	}
+
+	// The Dolphins win at home, leaving the two teams tied at 1-1
+	m.Update(KeyHomeWin)
+	if len(m.picks) != 1 {
+		t.Fatalf("expected one pick, got %d", len(m.picks))
+	}
+	if m.groupOf(team.BuffaloBills.Name) == 0 || m.groupOf(team.BuffaloBills.Name) != m.groupOf(team.MiamiDolphins.Name) {
+		t.Fatalf("expected the Bills and Dolphins to be in the same tied group")
+	}
+
+	m.Update(KeyTrace)
+	if !m.tracing || !strings.Contains(m.View(), "Tied group #1") {
+		t.Fatalf("expected the tiebreaker panel to be open")
+	}
+	m.Update(KeyBack)
+
+	m.Update(KeyClearAll)
+	if len(m.picks) != 0 || seedOf(team.BuffaloBills.Name) != 1 {
+		t.Fatalf("expected clearing the picks to restore the original seeds")
+	}
+
+	if !m.Update(KeyQuit) {
+		t.Fatalf("expected q to quit")
+	}
+}
@@ -0,0 +1,123 @@
+package tui
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// ANSI escape sequences used to redraw the screen
+const (
+	clearScreen = "\x1b[H\x1b[2J"
+	hideCursor  = "\x1b[?25l"
+	showCursor  = "\x1b[?25h"
+)
+
+// Run shows the model on the terminal and handles key presses until the user quits
+// The terminal is put in raw mode with stty for the duration, so in must be a terminal
+func Run(m *Model, in *os.File, out io.Writer) error {
+	restore, err := rawMode(in)
+	if err != nil {
+		return fmt.Errorf("the terminal UI needs an interactive terminal: %w", err)
+	}
+	defer restore()
+
+	fmt.Fprint(out, hideCursor)
+	defer fmt.Fprint(out, showCursor)
+
+	r := bufio.NewReader(in)
+	for {
+		// Raw mode doesn't translate newlines, so return to the start of each line explicitly
+		fmt.Fprint(out, clearScreen+strings.ReplaceAll(m.View(), "\n", "\r\n"))
+
+		k, err := readKey(r)
+		if err != nil {
+			return err
+		}
+		if m.Update(k) {
+			fmt.Fprint(out, clearScreen)
+			return nil
+		}
+	}
+}
+
+// rawMode switches the terminal to raw mode without echo, returning a function that restores the previous settings
+func rawMode(in *os.File) (func(), error) {
+	stty := func(args ...string) (string, error) {
+		cmd := exec.Command("stty", args...)
+		cmd.Stdin = in
+		out, err := cmd.Output()
+		return strings.TrimSpace(string(out)), err
+	}
+
+	saved, err := stty("-g")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := stty("raw", "-echo"); err != nil {
+		return nil, err
+	}
+	return func() { stty(saved) }, nil
+}
+
+// readKey reads a single key press, including the escape sequences sent by arrow keys
+func readKey(r *bufio.Reader) (Key, error) {
+	c, err := r.ReadByte()
+	if err != nil {
+		return KeyNone, err
+	}
+
+	switch c {
+	case 'k':
+		return KeyUp, nil
+	case 'j':
+		return KeyDown, nil
+	case 'h':
+		return KeyHomeWin, nil
+	case 'a':
+		return KeyAwayWin, nil
+	case 't':
+		return KeyTie, nil
+	case 'x', 127:
+		return KeyClear, nil
+	case 'X':
+		return KeyClearAll, nil
+	case '\t':
+		return KeyConference, nil
+	case 'g':
+		return KeyTrace, nil
+	case 'n':
+		return KeyNextGroup, nil
+	case 'p':
+		return KeyPrevGroup, nil
+	case 'q', 3: // 3 is Ctrl-C, which raw mode delivers as a byte instead of a signal
+		return KeyQuit, nil
+	case 0x1b:
+		return readEscape(r)
+	}
+	return KeyNone, nil
+}
+
+// readEscape reads the rest of an escape sequence. A lone escape is the escape key itself
+func readEscape(r *bufio.Reader) (Key, error) {
+	if r.Buffered() == 0 {
+		return KeyBack, nil
+	}
+	if c, _ := r.ReadByte(); c != '[' {
+		return KeyBack, nil
+	}
+	c, err := r.ReadByte()
+	if err != nil {
+		return KeyNone, err
+	}
+	switch c {
+	case 'A':
+		return KeyUp, nil
+	case 'B':
+		return KeyDown, nil
+	}
+	return KeyNone, nil
+}
@@ -0,0 +1,126 @@
+package tui
+
+import (
+	"fmt"
+	"nfl-app/internal/entry"
+	"nfl-app/internal/playoff"
+	"nfl-app/internal/standings"
+	"nfl-app/internal/team"
+	"strings"
+	"text/tabwriter"
+)
+
+// gamesShown is how many remaining games are listed at once, scrolling with the cursor
+const gamesShown = 12
+
+// View renders the whole screen
+func (m *Model) View() string {
+	var b strings.Builder
+
+	conf := m.currentConference()
+	fmt.Fprintf(&b, "%s standings    %d of %d remaining games picked\n\n",
+		team.ConferenceAbbreviation(conf), len(m.picks), len(m.games))
+	m.viewStandings(&b, conf)
+	b.WriteString("\n")
+
+	if m.tracing {
+		m.viewTrace(&b)
+		b.WriteString("\nn/p next/previous group   g/esc close   q quit\n")
+	} else {
+		m.viewGames(&b)
+		b.WriteString("\nup/down move   h home win   a away win   t tie   x clear   X clear all\n")
+		b.WriteString("tab other conference   g tiebreakers   q quit\n")
+	}
+
+	if m.err != nil {
+		fmt.Fprintf(&b, "\nerror: %v\n", m.err)
+	}
+	return b.String()
+}
+
+func (m *Model) viewStandings(b *strings.Builder, conf string) {
+	tw := tabwriter.NewWriter(b, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "Seed\tTeam\tRecord\tPct\tDivision\tDiv rank\tTied")
+	for _, e := range m.standings.Conference(conf) {
+		seed := fmt.Sprint(e.Stats.Seed)
+		if e.Stats.Seed > playoff.SeedsPerConference {
+			seed = ""
+		}
+		tied := ""
+		if g := m.groupOf(e.Team.Name); g > 0 {
+			tied = fmt.Sprintf("#%d", g)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%.3f\t%s\t%d\t%s\n", seed, e.Team.Name, record(e), e.Stats.Record.WinPercentage(),
+			e.Team.Division, e.Stats.DivisionRank, tied)
+
+		// Mark the playoff line
+		if e.Stats.Seed == playoff.SeedsPerConference {
+			fmt.Fprintln(tw, "----\t\t\t\t\t\t")
+		}
+	}
+	tw.Flush()
+}
+
+func (m *Model) viewGames(b *strings.Builder) {
+	if len(m.games) == 0 {
+		b.WriteString("No games left to pick\n")
+		return
+	}
+
+	// Keep the cursor in the window
+	start := min(max(m.cursor-gamesShown/2, 0), max(len(m.games)-gamesShown, 0))
+	end := min(start+gamesShown, len(m.games))
+
+	fmt.Fprintf(b, "Remaining games %d-%d of %d\n", start+1, end, len(m.games))
+	tw := tabwriter.NewWriter(b, 0, 0, 2, ' ', 0)
+	for i := start; i < end; i++ {
+		sg := m.games[i]
+		cursor := " "
+		if i == m.cursor {
+			cursor = ">"
+		}
+
+		pick := ""
+		if o, ok := m.picks[i]; ok {
+			pick = describe(o, sg.Game.Home, sg.Game.Away)
+		}
+		fmt.Fprintf(tw, "%s\tWeek %d\t%s @ %s\t%s\n", cursor, sg.Week, sg.Game.Away, sg.Game.Home, pick)
+	}
+	tw.Flush()
+}
+
+func (m *Model) viewTrace(b *strings.Builder) {
+	group := m.groups[m.group]
+	fmt.Fprintf(b, "Tied group #%d of %d: %s\n\n", m.group+1, len(m.groups), names(group))
+	if m.trace == nil || len(m.trace.Steps) == 0 {
+		b.WriteString("No tiebreakers applied\n")
+		return
+	}
+	b.WriteString(m.trace.String())
+}
+
+func describe(o standings.Outcome, home, away string) string {
+	switch o {
+	case standings.HomeWin:
+		return home + " win"
+	case standings.AwayWin:
+		return away + " win"
+	}
+	return "tie"
+}
+
+func record(e entry.Entry) string {
+	r := e.Stats.Record
+	if r.Ties() > 0 {
+		return fmt.Sprintf("%d-%d-%d", r.Wins(), r.Losses(), r.Ties())
+	}
+	return fmt.Sprintf("%d-%d", r.Wins(), r.Losses())
+}
+
+func names(entries []entry.Entry) string {
+	out := make([]string, len(entries))
+	for i, e := range entries {
+		out[i] = e.Team.Name
+	}
+	return strings.Join(out, ", ")
+}
@@ -0,0 +1,148 @@
+package whatif
+
+import (
+	"fmt"
+	"nfl-app/internal/entrysort"
+	"nfl-app/internal/game"
+	"nfl-app/internal/schedule"
+	"nfl-app/internal/standings"
+)
+
+// Pick is a chosen result for a game that has not been played yet
+type Pick struct {
+	// Week is optional. When zero, the game is found by its teams alone
+	Week int `json:"week,omitempty"`
+
+	// Home and Away may be given either way round, the scheduled game decides which is which
+	Home string `json:"home"`
+	Away string `json:"away"`
+
+	// Winner is the name of the winning team, left empty for a tie
+	Winner string `json:"winner,omitempty"`
+	Tie    bool   `json:"tie,omitempty"`
+
+	// Scores are optional, and both zero when not given
+	HomeScore int `json:"homeScore,omitempty"`
+	AwayScore int `json:"awayScore,omitempty"`
+}
+
+// Validate checks the pick is internally consistent
+func (p Pick) Validate() error {
+	if p.Home == "" || p.Away == "" || p.Home == p.Away {
+		return fmt.Errorf("pick needs two different teams, got %q and %q", p.Home, p.Away)
+	}
+	if p.Tie && p.Winner != "" {
+		return fmt.Errorf("%s@%s: a tie cannot have a winner", p.Away, p.Home)
+	}
+	if !p.Tie && p.Winner != p.Home && p.Winner != p.Away {
+		return fmt.Errorf("%s@%s: winner %q did not play in the game", p.Away, p.Home, p.Winner)
+	}
+
+	scored := p.HomeScore != 0 || p.AwayScore != 0
+	switch {
+	case p.HomeScore < 0 || p.AwayScore < 0:
+		return fmt.Errorf("%s@%s: scores cannot be negative", p.Away, p.Home)
+	case scored && p.Tie && p.HomeScore != p.AwayScore:
+		return fmt.Errorf("%s@%s: a tie needs equal scores", p.Away, p.Home)
+	case scored && p.Winner == p.Home && p.HomeScore <= p.AwayScore,
+		scored && p.Winner == p.Away && p.AwayScore <= p.HomeScore:
+		return fmt.Errorf("%s@%s: the winner must have the higher score", p.Away, p.Home)
+	}
+
+	return nil
+}
+
+// Result returns the game the pick describes
+func (p Pick) Result(g game.Game) game.Game {
+	g.Winner, g.Loser = "", ""
+	g.PtsWin, g.PtsLose = p.HomeScore, p.AwayScore
+
+	switch p.Winner {
+	case g.Home:
+		g.Winner, g.Loser = g.Home, g.Away
+	case g.Away:
+		g.Winner, g.Loser = g.Away, g.Home
+		g.PtsWin, g.PtsLose = p.AwayScore, p.HomeScore
+	}
+
+	return g
+}
+
+// Apply returns a copy of the base schedule with every pick played. The base schedule is not modified
+func Apply(base schedule.Schedule, picks []Pick) (schedule.Schedule, error) {
+	sched := base.Clone()
+
+	for _, p := range picks {
+		if err := p.Validate(); err != nil {
+			return schedule.Schedule{}, err
+		}
+
+		sg, err := find(sched, p)
+		if err != nil {
+			return schedule.Schedule{}, err
+		}
+
+		// Picks may name the teams either way round
+		if sg.Game.Home != p.Home {
+			p.Home, p.Away = p.Away, p.Home
+			p.HomeScore, p.AwayScore = p.AwayScore, p.HomeScore
+		}
+
+		if err := sched.Play(sg.Week, p.Result(sg.Game)); err != nil {
+			return schedule.Schedule{}, err
+		}
+	}
+
+	return sched, nil
+}
+
+// find returns the remaining game a pick refers to, matching its teams in either order
+func find(sched schedule.Schedule, p Pick) (schedule.ScheduledGame, error) {
+	matches := make([]schedule.ScheduledGame, 0)
+	for _, sg := range sched.RemainingGames() {
+		if p.Week != 0 && sg.Week != p.Week {
+			continue
+		}
+		g := sg.Game
+		if (g.Home == p.Home && g.Away == p.Away) || (g.Home == p.Away && g.Away == p.Home) {
+			matches = append(matches, sg)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return schedule.ScheduledGame{}, fmt.Errorf("no remaining game between %s and %s", p.Away, p.Home)
+	case 1:
+		return matches[0], nil
+	}
+	return schedule.ScheduledGame{}, fmt.Errorf("%s and %s play more than once, give a week", p.Away, p.Home)
+}
+
+// Result holds the standings after applying a set of picks
+type Result struct {
+	Schedule  schedule.Schedule
+	Standings standings.Standings
+
+	// Traces holds the tiebreaker trace of each division ranking and conference seeding, keyed by division or
+	// conference
+	Traces map[string]*entrysort.Trace
+}
+
+// Evaluate applies the picks to a copy of the base schedule and recomputes the standings, seeding and tiebreakers
+func Evaluate(base schedule.Schedule, picks []Pick) (Result, error) {
+	sched, err := Apply(base, picks)
+	if err != nil {
+		return Result{}, err
+	}
+
+	st, traces, err := standings.ComputeTrace(sched)
+	if err != nil {
+		return Result{}, err
+	}
+
+	return Result{
+		Schedule:  sched,
+		Standings: st,
+		Traces:    traces,
+	}, nil
+}
@@ -0,0 +1,162 @@
+package whatif
+
+import (
+	"nfl-app/internal/game"
+	"nfl-app/internal/schedule"
+	"nfl-app/internal/team"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func teamsIn(division string) []string {
+	var names []string
+	for _, t := range team.NFLTeams {
+		if t.Division == division {
+			names = append(names, t.Name)
+		}
+	}
+	return names
+}
+
+func TestPickValidate(t *testing.T) {
+	east := teamsIn(team.AFCEast)
+	a, b, c := east[0], east[1], east[2]
+
+	tests := []struct {
+		name  string
+		pick  Pick
+		valid bool
+	}{
+		{"home win", Pick{Home: a, Away: b, Winner: a}, true},
+		{"scored away win", Pick{Home: a, Away: b, Winner: b, HomeScore: 10, AwayScore: 13}, true},
+		{"tie", Pick{Home: a, Away: b, Tie: true}, true},
+		{"scored tie", Pick{Home: a, Away: b, Tie: true, HomeScore: 20, AwayScore: 20}, true},
+		{"missing team", Pick{Home: a, Winner: a}, false},
+		{"same team twice", Pick{Home: a, Away: a, Winner: a}, false},
+		{"tie with a winner", Pick{Home: a, Away: b, Winner: a, Tie: true}, false},
+		{"no winner", Pick{Home: a, Away: b}, false},
+		{"winner not in the game", Pick{Home: a, Away: b, Winner: c}, false},
+		{"negative score", Pick{Home: a, Away: b, Winner: a, HomeScore: 7, AwayScore: -3}, false},
+		{"tie with unequal scores", Pick{Home: a, Away: b, Tie: true, HomeScore: 20, AwayScore: 17}, false},
+		{"winner with the lower score", Pick{Home: a, Away: b, Winner: a, HomeScore: 17, AwayScore: 20}, false},
+		{"winner with an equal score", Pick{Home: a, Away: b, Winner: b, HomeScore: 17, AwayScore: 17}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.pick.Validate(); (err == nil) != tt.valid {
+				t.Errorf("expected valid to be %v, got error %v", tt.valid, err)
+			}
+		})
+	}
+}
+
+// remaining returns a schedule with one game played, a and b meeting in weeks 1 and 2, and c hosting d in week 1
+func remaining() (sched schedule.Schedule, a, b, c, d string) {
+	afc, nfc := teamsIn(team.AFCEast), teamsIn(team.NFCEast)
+	a, b, c, d = afc[0], afc[1], nfc[0], nfc[1]
+
+	sched = schedule.NewSchedule()
+	sched.AddGame(1, game.Game{Home: afc[2], Away: afc[3], Winner: afc[2], Loser: afc[3], PtsWin: 21, PtsLose: 14})
+	sched.AddRemaining(1, game.Game{Home: a, Away: b})
+	sched.AddRemaining(1, game.Game{Home: c, Away: d})
+	sched.AddRemaining(2, game.Game{Home: b, Away: a})
+	return sched, a, b, c, d
+}
+
+func TestApplyLeavesBaseUnchanged(t *testing.T) {
+	base, a, b, c, d := remaining()
+	before := base.Clone()
+
+	picks := []Pick{
+		{Week: 1, Home: a, Away: b, Tie: true, HomeScore: 17, AwayScore: 17},
+		// Named the other way round from the schedule, with the scores following the teams
+		{Home: d, Away: c, Winner: d, HomeScore: 24, AwayScore: 20},
+		{Week: 2, Home: b, Away: a, Winner: b},
+	}
+
+	sched, err := Apply(base, picks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(base, before) {
+		t.Errorf("expected Apply to leave the base schedule unchanged")
+	}
+	if left := sched.RemainingGames(); len(left) != 0 {
+		t.Errorf("expected every game to be played, %d left", len(left))
+	}
+
+	want := map[int][]game.Game{
+		1: {
+			{Home: a, Away: b, PtsWin: 17, PtsLose: 17},
+			{Home: c, Away: d, Winner: d, Loser: c, PtsWin: 24, PtsLose: 20},
+		},
+		2: {{Home: b, Away: a, Winner: b, Loser: a}},
+	}
+	for week, games := range want {
+		for _, g := range games {
+			if !slices.Contains(sched.Weeks[week-1].Games, g) {
+				t.Errorf("expected week %d to have %+v, got %+v", week, g, sched.Weeks[week-1].Games)
+			}
+		}
+	}
+
+	if _, err := Evaluate(base, picks); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(base, before) {
+		t.Errorf("expected Evaluate to leave the base schedule unchanged")
+	}
+}
+
+func TestApplyErrors(t *testing.T) {
+	base, a, b, c, _ := remaining()
+	before := base.Clone()
+
+	tests := []struct {
+		name  string
+		picks []Pick
+	}{
+		{"invalid pick", []Pick{{Home: a, Away: b}}},
+		{"no such game", []Pick{{Home: a, Away: c, Winner: a}}},
+		{"plays twice without a week", []Pick{{Home: a, Away: b, Winner: a}}},
+		{"wrong week", []Pick{{Week: 3, Home: a, Away: b, Winner: a}}},
+		{"picked twice", []Pick{{Week: 1, Home: a, Away: b, Winner: a}, {Week: 1, Home: a, Away: b, Tie: true}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Apply(base, tt.picks); err == nil {
+				t.Errorf("expected an error")
+			}
+			if !reflect.DeepEqual(base, before) {
+				t.Errorf("expected the base schedule to be unchanged")
+			}
+		})
+	}
+}
+
+func TestEvaluateTraces(t *testing.T) {
+	base, a, b, _, _ := remaining()
+	rival := teamsIn(team.AFCEast)[2]
+
+	result, err := Evaluate(base, []Pick{{Week: 1, Home: a, Away: b, Winner: a}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, group := range slices.Concat(team.Divisions, team.Conferences) {
+		if result.Traces[group] == nil {
+			t.Errorf("expected a trace for %s", group)
+		}
+	}
+
+	// a and the rival both finish 1-0, and the trace must tell how the division leader in the standings was picked
+	leader := result.Standings.Division(team.AFCEast)[0].Team.Name
+	step, ok := result.Traces[team.AFCEast].Deciding([]string{a, rival})
+	if !ok {
+		t.Fatalf("expected a step separating %s and %s, got\n%s", a, rival, result.Traces[team.AFCEast])
+	}
+	if !strings.HasPrefix(step.Outcome, leader) {
+		t.Errorf("expected the trace to rank %s first, got %s", leader, step)
+	}
+}